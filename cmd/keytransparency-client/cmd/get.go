@@ -20,6 +20,8 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+
+	"github.com/google/keytransparency/core/client"
 )
 
 // getCmd represents the get command
@@ -41,10 +43,21 @@ results are consistent.`,
 		if err != nil {
 			return fmt.Errorf("error connecting: %v", err)
 		}
-		profile, _, err := c.GetUser(ctx, userID)
+		_, profile, err := c.GetUser(ctx, userID)
+		if err == client.ErrDeleted {
+			fmt.Printf("Profile for %v: deleted\n", userID)
+			return nil
+		}
 		if err != nil {
 			return fmt.Errorf("failed to get user: %v", err)
 		}
+		if p, err := client.ParseProfile(profile); err == nil && len(p.GetDevices()) > 0 {
+			fmt.Printf("Devices for %v:\n", userID)
+			for _, d := range p.GetDevices() {
+				fmt.Printf("  %v: %x %v\n", d.GetLabel(), d.GetPublicKey(), d.GetMetadata())
+			}
+			return nil
+		}
 		fmt.Printf("Profile for %v: %+v\n", userID, profile)
 		return nil
 	},
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"time"
 
 	"github.com/google/tink/go/keyset"
 	"github.com/google/tink/go/signature"
@@ -33,7 +34,8 @@ import (
 )
 
 var (
-	data string
+	data     string
+	validFor time.Duration
 )
 
 // postCmd represents the post command
@@ -104,6 +106,9 @@ User email MUST match the OAuth account used to authorize the update.
 			PublicKeyData:  profileData,
 			AuthorizedKeys: authorizedKeys,
 		}
+		if validFor > 0 {
+			u.NotAfter = time.Now().Add(validFor)
+		}
 		timeout := viper.GetDuration("timeout")
 		cctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
@@ -127,4 +132,5 @@ func init() {
 	}
 
 	postCmd.PersistentFlags().StringVarP(&data, "data", "d", "", "hex encoded key data")
+	postCmd.PersistentFlags().DurationVar(&validFor, "valid-for", 0, "Stop serving the profile after this long unless it is posted again. Zero keeps the current period")
 }
@@ -21,13 +21,17 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/google/keytransparency/core/client"
 	"github.com/google/keytransparency/core/client/tracker"
 	"github.com/google/keytransparency/core/client/verifier"
+	"github.com/google/keytransparency/core/testdata"
 	"github.com/google/keytransparency/impl/authentication"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/google/trillian"
 	"github.com/google/trillian/crypto/keys/der"
 	"github.com/google/trillian/crypto/keys/pem"
@@ -44,8 +48,9 @@ import (
 )
 
 var (
-	cfgFile string
-	verbose bool
+	cfgFile  string
+	verbose  bool
+	recorder *client.Recorder
 )
 
 // RootCmd represents the base command when called without any subcommands
@@ -66,9 +71,25 @@ server provides to ensure that account data is accurate.`,
 // Execute adds all child commands to the root command sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	if err := RootCmd.Execute(); err != nil {
+	err := RootCmd.Execute()
+	// Save the transcript even if the command failed, since failures are
+	// what transcripts are most useful for.
+	if err := writeTranscript(); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to write transcript: %v\n", err)
 		os.Exit(1)
 	}
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+// writeTranscript saves the RPCs recorded by the --record flag.
+func writeTranscript() error {
+	path := viper.GetString("record")
+	if path == "" || recorder == nil {
+		return nil
+	}
+	return testdata.WriteTranscriptFile(path, recorder.Transcript())
 }
 
 func init() {
@@ -80,6 +101,10 @@ func init() {
 	RootCmd.PersistentFlags().String("kt-cert", "", "Path to public key for Key Transparency")
 	RootCmd.PersistentFlags().Bool("autoconfig", true, "Fetch config info from the server's /v1/directory/info")
 	RootCmd.PersistentFlags().Bool("insecure", false, "Skip TLS checks")
+	RootCmd.PersistentFlags().String("trust-store", "", "File for saving the last verified log root (default is $HOME/.keytransparency/<kt-url>/<directory>.logroot)")
+	RootCmd.PersistentFlags().String("record", "", "Record server responses to a transcript file that can be checked with verify-transcript")
+	RootCmd.PersistentFlags().Duration("freshness-skew", 0, "Reject map roots older than the directory's max_interval plus this skew. Zero disables the check")
+	RootCmd.PersistentFlags().String("misbehavior-reports", "", "Directory for writing evidence of server misbehavior (default is a misbehavior directory next to the trust store)")
 
 	RootCmd.PersistentFlags().String("vrf", "genfiles/vrf-pubkey.pem", "path to vrf public key")
 
@@ -195,7 +220,15 @@ func dial(ctx context.Context) (pb.KeyTransparencyClient, error) {
 		return nil, err
 	}
 
-	cc, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(transportCreds))
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(transportCreds)}
+	if viper.GetString("record") != "" {
+		recorder = client.NewRecorder(fmt.Sprintf("keytransparency-client %v", strings.Join(os.Args[1:], " ")))
+		opts = append(opts,
+			grpc.WithUnaryInterceptor(recorder.UnaryClientInterceptor),
+			grpc.WithStreamInterceptor(recorder.StreamClientInterceptor))
+	}
+
+	cc, err := grpc.DialContext(ctx, addr, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("grpc.DialContext(%v): %v", addr, err)
 	}
@@ -214,10 +247,73 @@ func GetClient(ctx context.Context) (*client.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("config: %v", err)
 	}
+	if recorder != nil {
+		recorder.SetDirectory(config)
+	}
+
+	// Verify consistency from the log root saved by previous runs.
+	trustStorePath, err := trustStorePath(config.GetDirectoryId())
+	if err != nil {
+		return nil, err
+	}
+	reportDir := viper.GetString("misbehavior-reports")
+	if reportDir == "" {
+		reportDir = filepath.Join(filepath.Dir(trustStorePath), "misbehavior")
+	}
 
-	return client.NewFromConfig(ktCli, config,
-		func(lv *tclient.LogVerifier) verifier.LogTracker { return tracker.NewSynchronous(lv) },
+	var trackerErr error
+	c, err := client.NewFromConfig(ktCli, config,
+		func(lv *tclient.LogVerifier) verifier.LogTracker {
+			lt, err := tracker.NewFromTrustStore(lv, tracker.NewFileTrustStore(trustStorePath))
+			if err != nil {
+				trackerErr = err
+				return nil
+			}
+			lt.SetReporter(config, tracker.NewFileReporter(reportDir))
+			return lt
+		},
 	)
+	if trackerErr != nil {
+		return nil, fmt.Errorf("failed to read trusted log root from %v: %v", trustStorePath, trackerErr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := requireFreshness(c, config); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// requireFreshness enables the map root freshness check when --freshness-skew
+// is set.
+func requireFreshness(c *client.Client, config *pb.Directory) error {
+	skew := viper.GetDuration("freshness-skew")
+	if skew <= 0 {
+		return nil
+	}
+	if config.GetMaxInterval() == nil {
+		return fmt.Errorf("--freshness-skew: directory %v has no max_interval", config.GetDirectoryId())
+	}
+	maxInterval, err := ptypes.Duration(config.GetMaxInterval())
+	if err != nil {
+		return fmt.Errorf("--freshness-skew: %v", err)
+	}
+	c.RequireFreshness(maxInterval, skew)
+	return nil
+}
+
+// trustStorePath returns the file that holds the trusted log root for directoryID.
+func trustStorePath(directoryID string) (string, error) {
+	if path := viper.GetString("trust-store"); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	ktURL := strings.NewReplacer(":", "_", "/", "_").Replace(viper.GetString("kt-url"))
+	return filepath.Join(home, ".keytransparency", ktURL, directoryID+".logroot"), nil
 }
 
 // config selects a source for and returns the client configuration.
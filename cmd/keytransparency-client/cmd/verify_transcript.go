@@ -0,0 +1,67 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/keytransparency/core/client/verifier"
+	"github.com/google/keytransparency/core/testdata"
+	"github.com/spf13/cobra"
+)
+
+// verifyTranscriptCmd verifies saved server responses without contacting a server.
+var verifyTranscriptCmd = &cobra.Command{
+	Use:   "verify-transcript [transcript.json...]",
+	Short: "Verify recorded server responses offline",
+	Long: `Verify every request and response pair in one or more saved
+transcripts against the public keys recorded in each transcript. No server
+is contacted. All log roots in a transcript must be consistent with one
+another. Reports the RPC and the proof that failed to verify.`,
+	RunE: func(_ *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return fmt.Errorf("transcript file needs to be provided")
+		}
+		failed := 0
+		for _, path := range args {
+			transcript, err := testdata.ReadTranscriptFile(path)
+			if err != nil {
+				return fmt.Errorf("failed to read transcript %v: %v", path, err)
+			}
+			err = verifier.VerifyTranscript(transcript)
+			var terr *verifier.TranscriptError
+			switch {
+			case err == nil:
+				fmt.Printf("✓ %v: %v actions verified\n", path, len(transcript.GetActions()))
+			case errors.As(err, &terr):
+				failed++
+				fmt.Printf("✗ %v: action %d (%q): %v %v proof failed: %v\n",
+					path, terr.Action, terr.Desc, terr.RPC, terr.Proof, terr.Err)
+			default:
+				failed++
+				fmt.Printf("✗ %v: %v\n", path, err)
+			}
+		}
+		if failed > 0 {
+			return fmt.Errorf("%v of %v transcripts failed verification", failed, len(args))
+		}
+		return nil
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(verifyTranscriptCmd)
+}
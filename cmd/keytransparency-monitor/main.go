@@ -19,7 +19,9 @@ import (
 	"crypto"
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
@@ -32,9 +34,10 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	"github.com/google/keytransparency/cmd/serverutil"
-	"github.com/google/keytransparency/core/fake"
 	"github.com/google/keytransparency/core/monitor"
 	"github.com/google/keytransparency/core/monitorserver"
+	"github.com/google/keytransparency/core/monitorstorage"
+	"github.com/google/keytransparency/impl"
 	"github.com/google/keytransparency/internal/backoff"
 
 	mopb "github.com/google/keytransparency/core/api/monitor/v1/monitor_go_proto"
@@ -53,7 +56,9 @@ var (
 	signingKeyPassword = flag.String("password", "towel", "Password of the private key PEM file for SMH signing")
 	ktURL              = flag.String("kt-url", "localhost:443", "URL of key-server.")
 	insecure           = flag.Bool("insecure", false, "Skip TLS checks")
-	directoryID        = flag.String("directoryid", "", "KT Directory identifier to monitor")
+	directoryIDs       = flag.String("directoryid", "", "Comma separated list of KT Directory identifiers to monitor")
+	dbPath             = flag.String("db", "", "Database connection string")
+	dbEngine           = flag.String("db_engine", "inmemory", fmt.Sprintf("Storage engines: %v", impl.StorageEngines()))
 )
 
 func main() {
@@ -67,48 +72,48 @@ func main() {
 	}
 	ktClient := pb.NewKeyTransparencyClient(cc)
 
-	// The first gRPC command might fail while the keyserver is starting up. Retry for up to 1 minute.
-	cctx, cancel := context.WithTimeout(ctx, time.Minute)
-	defer cancel()
-	b := backoff.Backoff{
-		Min:    time.Millisecond,
-		Max:    time.Second,
-		Factor: 1.5,
-	}
-	var config *pb.Directory
-	if err := b.Retry(cctx, func() (err error) {
-		config, err = ktClient.GetDirectory(ctx, &pb.GetDirectoryRequest{DirectoryId: *directoryID})
-		if err != nil {
-			glog.Errorf("GetDirectory(%v/%v): %v", *ktURL, *directoryID, err)
-		}
-		return
-	}, codes.Unavailable); err != nil {
-		glog.Exitf("Could not read directory info %v:", err)
-	}
-
 	// Read signing key:
 	key, err := pem.ReadPrivateKeyFile(*signingKey, *signingKeyPassword)
 	if err != nil {
 		glog.Exitf("Could not create signer from %v: %v", *signingKey, err)
 	}
 	signer := tcrypto.NewSigner(0, key, crypto.SHA256)
-	store := fake.NewMonitorStorage()
 
-	// Create monitoring background process.
-	mon, err := monitor.NewFromDirectory(ktClient, config, signer, store)
+	db, err := impl.NewStorage(ctx, *dbEngine, *dbPath)
 	if err != nil {
-		glog.Exitf("Failed to initialize monitor: %v", err)
+		glog.Exitf("Failed to connect to database: %v", err)
 	}
-
-	go func() {
-		if err := mon.ProcessLoop(ctx, 0); err != nil {
-			glog.Errorf("ProcessLoop: %v", err)
-		}
-	}()
+	defer db.Close()
+	store := db.MonitorResults
 
 	// Monitor Server.
 	srv := monitorserver.New(store)
 
+	for _, directoryID := range strings.Split(*directoryIDs, ",") {
+		config := getDirectory(ctx, ktClient, directoryID)
+		if err := srv.AddDirectory(*ktURL, config); err != nil {
+			glog.Exitf("Failed to accept gossip for %v: %v", directoryID, err)
+		}
+
+		// Create monitoring background process.
+		mon, err := monitor.NewFromDirectory(*ktURL, ktClient, config, signer, store)
+		if err != nil {
+			glog.Exitf("Failed to initialize monitor for %v: %v", directoryID, err)
+		}
+
+		// Resume from the last revision processed.
+		startRev, err := store.LatestRevision(ctx, *ktURL, directoryID)
+		if err != nil && err != monitorstorage.ErrNotFound {
+			glog.Exitf("Failed to read latest revision for %v: %v", directoryID, err)
+		}
+
+		go func(directoryID string) {
+			if err := mon.ProcessLoop(ctx, startRev); err != nil {
+				glog.Errorf("ProcessLoop(%v): %v", directoryID, err)
+			}
+		}(directoryID)
+	}
+
 	// Create gRPC server.
 	grpcServer := grpc.NewServer(
 		grpc.StreamInterceptor(grpc_prometheus.StreamServerInterceptor),
@@ -139,6 +144,29 @@ func main() {
 	glog.Errorf("Monitor exiting: %v", g.Wait())
 }
 
+// getDirectory reads the directory config for directoryID.
+func getDirectory(ctx context.Context, ktClient pb.KeyTransparencyClient, directoryID string) *pb.Directory {
+	// The first gRPC command might fail while the keyserver is starting up. Retry for up to 1 minute.
+	cctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	b := backoff.Backoff{
+		Min:    time.Millisecond,
+		Max:    time.Second,
+		Factor: 1.5,
+	}
+	var config *pb.Directory
+	if err := b.Retry(cctx, func() (err error) {
+		config, err = ktClient.GetDirectory(cctx, &pb.GetDirectoryRequest{DirectoryId: directoryID})
+		if err != nil {
+			glog.Errorf("GetDirectory(%v/%v): %v", *ktURL, directoryID, err)
+		}
+		return
+	}, codes.Unavailable); err != nil {
+		glog.Exitf("Could not read directory info %v:", err)
+	}
+	return config
+}
+
 func dial(url string, insecure bool) (*grpc.ClientConn, error) {
 	tcreds, err := transportCreds(url, insecure)
 	if err != nil {
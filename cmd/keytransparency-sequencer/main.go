@@ -39,6 +39,7 @@ import (
 	"github.com/google/keytransparency/core/adminserver"
 	"github.com/google/keytransparency/core/sequencer"
 	"github.com/google/keytransparency/core/sequencer/election"
+	"github.com/google/keytransparency/core/sequencer/runner"
 	"github.com/google/keytransparency/impl"
 	"github.com/google/keytransparency/internal/forcemaster"
 
@@ -69,9 +70,13 @@ var (
 	mapURL = flag.String("map-url", "", "URL of Trillian Map Server")
 	logURL = flag.String("log-url", "", "URL of Trillian Log Server for Signed Map Heads")
 
-	dirRefresh = flag.Duration("directory-refresh", 5*time.Second, "Time to detect new directory")
-	refresh    = flag.Duration("refresh", 5*time.Second, "Time between map revision construction runs")
-	batchSize  = flag.Int("batch-size", 100, "Maximum number of mutations to process per map revision")
+	dirRefresh  = flag.Duration("directory-refresh", 5*time.Second, "Time to detect new directory")
+	refresh     = flag.Duration("refresh", 5*time.Second, "Time between map revision construction runs")
+	batchSize   = flag.Int("batch-size", 100, "Maximum number of mutations to process per map revision")
+	minBatch    = flag.Int("min-batch", 100, "Minimum number of mutations to define a map revision before the directory's MinInterval has passed")
+	shards      = flag.Int("apply-shards", 1, "Number of index ranges to split a map revision into while applying it")
+	parallelism = flag.Int("apply-parallelism", 0, "Maximum number of index ranges to apply at once, which bounds memory use. Zero means one per CPU")
+	runnerName  = flag.String("runner", runner.NativeRunner, fmt.Sprintf("Revision pipeline runners: %v", runner.Runners()))
 )
 
 // getElectionFactory returns an election factory based on flags, and a
@@ -104,6 +109,9 @@ func getElectionFactory() (election2.Factory, func()) {
 
 func main() {
 	flag.Parse()
+	if _, err := runner.Get(*runnerName); err != nil {
+		glog.Exit(err)
+	}
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -137,15 +145,24 @@ func main() {
 	}
 	defer done()
 
-	spb.RegisterKeyTransparencySequencerServer(grpcServer, sequencer.NewServer(
+	sequencerServer := sequencer.NewServer(
 		db.Directories,
 		trillian.NewTrillianLogClient(lconn),
 		trillian.NewTrillianMapClient(mconn),
 		trillian.NewTrillianMapWriteClient(mconn),
 		db.Batches,
+		db.Expiries,
 		db.Logs,
 		spb.NewKeyTransparencySequencerClient(conn),
-		prometheus.MetricFactory{}))
+		prometheus.MetricFactory{})
+	// Heartbeat revisions are defined, applied and published on separate
+	// refresh ticks, so start them early enough to be visible before
+	// MaxInterval expires.
+	sequencerServer.HeartbeatLead = 3 * *refresh
+	sequencerServer.ApplyRevisionShards = *shards
+	sequencerServer.ApplyRevisionParallelism = *parallelism
+	sequencerServer.Runner = *runnerName
+	spb.RegisterKeyTransparencySequencerServer(grpcServer, sequencerServer)
 
 	pb.RegisterKeyTransparencyAdminServer(grpcServer, adminserver.New(
 		trillian.NewTrillianLogClient(lconn),
@@ -216,7 +233,7 @@ func runSequencer(ctx context.Context, conn *grpc.ClientConn, directoryStorage d
 	})
 
 	go sequencer.PeriodicallyRun(ctx, time.Tick(*refresh), func(ctx context.Context) {
-		if err := signer.DefineRevisionsForAllMasterships(ctx, int32(*batchSize)); err != nil {
+		if err := signer.DefineRevisionsForAllMasterships(ctx, int32(*minBatch), int32(*batchSize)); err != nil {
 			glog.Errorf("PeriodicallyRun(DefineRevisionsForAllMasterships): %v", err)
 		}
 	})
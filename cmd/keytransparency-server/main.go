@@ -33,7 +33,6 @@ import (
 
 	"github.com/google/keytransparency/cmd/serverutil"
 	"github.com/google/keytransparency/core/keyserver"
-	"github.com/google/keytransparency/core/mutator/entry"
 	"github.com/google/keytransparency/impl"
 	"github.com/google/keytransparency/impl/authentication"
 	"github.com/google/keytransparency/impl/authorization"
@@ -101,7 +100,7 @@ func main() {
 	tmap := trillian.NewTrillianMapClient(mconn)
 
 	// Create gRPC server.
-	ksvr := keyserver.New(tlog, tmap, entry.IsValidEntry, db.Directories, db.Logs, db.Batches,
+	ksvr := keyserver.New(tlog, tmap, db.Directories, db.Logs, db.Batches,
 		prometheus.MetricFactory{}, int32(*revisionPageSize))
 
 	logger := log.NewLogfmtLogger(os.Stdout)
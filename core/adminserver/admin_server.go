@@ -16,8 +16,10 @@
 package adminserver
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/golang/glog"
@@ -28,8 +30,12 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/google/keytransparency/core/crypto/commitments"
 	"github.com/google/keytransparency/core/crypto/vrf/p256"
 	"github.com/google/keytransparency/core/directory"
+	"github.com/google/keytransparency/core/mutator/entry"
+	"github.com/google/keytransparency/core/water"
+	"github.com/google/tink/go/keyset"
 	"github.com/google/trillian/client"
 	"github.com/google/trillian/crypto/keys"
 	"github.com/google/trillian/crypto/keys/der"
@@ -88,6 +94,8 @@ type LogsAdmin interface {
 	SetWritable(ctx context.Context, directoryID string, logID int64, enabled bool) error
 	// ListLogs returns a list of logs, optionally filtered by the writable bit.
 	ListLogs(ctx context.Context, directoryID string, writable bool) ([]int64, error)
+	// SendBatch submits the whole group of mutations atomically to a given log.
+	SendBatch(ctx context.Context, directoryID string, logID int64, batch []*pb.EntryUpdate) (water.Mark, error)
 }
 
 // Batcher writes batch definitions to storage.
@@ -155,13 +163,16 @@ func (s *Server) ListDirectories(ctx context.Context, in *pb.ListDirectoriesRequ
 // by fetching the relevant info from Trillian.
 func (s *Server) fetchDirectory(ctx context.Context, d *directory.Directory) (*pb.Directory, error) {
 	return &pb.Directory{
-		DirectoryId: d.DirectoryID,
-		Log:         d.Log,
-		Map:         d.Map,
-		Vrf:         d.VRF,
-		MinInterval: ptypes.DurationProto(d.MinInterval),
-		MaxInterval: ptypes.DurationProto(d.MaxInterval),
-		Deleted:     d.Deleted,
+		DirectoryId:         d.DirectoryID,
+		Log:                 d.Log,
+		Map:                 d.Map,
+		Vrf:                 d.VRF,
+		MinInterval:         ptypes.DurationProto(d.MinInterval),
+		MaxInterval:         ptypes.DurationProto(d.MaxInterval),
+		Deleted:             d.Deleted,
+		Mutator:             d.Mutator,
+		AllowReregistration: d.AllowReregistration,
+		AdminKeyset:         d.AdminKeyset,
 	}, nil
 }
 
@@ -225,6 +236,14 @@ func (s *Server) CreateDirectory(ctx context.Context, in *pb.CreateDirectoryRequ
 		// Directory already exists.
 		return nil, status.Errorf(codes.AlreadyExists, "Directory %v already exists or is soft deleted.", in.GetDirectoryId())
 	}
+	if _, err := entry.GetMutator(in.GetMutator(), entry.Options{AllowReregistration: in.GetAllowReregistration()}); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "adminserver: GetMutator(): %v", status.Convert(err).Message())
+	}
+	if ks := in.GetAdminKeyset(); len(ks) > 0 {
+		if _, err := keyset.ReadWithNoSecrets(keyset.NewBinaryReader(bytes.NewBuffer(ks))); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "adminserver: invalid admin keyset: %v", err)
+		}
+	}
 
 	// Generate VRF key.
 	wrapped, err := privKeyOrGen(ctx, in.GetVrfPrivateKey(), s.keygen)
@@ -278,13 +297,16 @@ func (s *Server) CreateDirectory(ctx context.Context, in *pb.CreateDirectoryRequ
 
 	// Create directory - {log, map} binding.
 	dir := &directory.Directory{
-		DirectoryID: in.GetDirectoryId(),
-		Map:         trimmedMap,
-		Log:         trimmedLog,
-		VRF:         vrfPublicPB,
-		VRFPriv:     wrapped,
-		MinInterval: minInterval,
-		MaxInterval: maxInterval,
+		DirectoryID:         in.GetDirectoryId(),
+		Map:                 trimmedMap,
+		Log:                 trimmedLog,
+		VRF:                 vrfPublicPB,
+		VRFPriv:             wrapped,
+		MinInterval:         minInterval,
+		MaxInterval:         maxInterval,
+		Mutator:             in.GetMutator(),
+		AllowReregistration: in.GetAllowReregistration(),
+		AdminKeyset:         in.GetAdminKeyset(),
 	}
 	if s := status.Convert(s.directories.Write(ctx, dir)); s.Code() != codes.OK {
 		return nil, status.Errorf(s.Code(), "adminserver: directories.Write(): %v", s.Message())
@@ -303,12 +325,15 @@ func (s *Server) CreateDirectory(ctx context.Context, in *pb.CreateDirectoryRequ
 	}
 
 	d := &pb.Directory{
-		DirectoryId: in.GetDirectoryId(),
-		Log:         trimmedLog,
-		Map:         trimmedMap,
-		Vrf:         vrfPublicPB,
-		MinInterval: in.MinInterval,
-		MaxInterval: in.MaxInterval,
+		DirectoryId:         in.GetDirectoryId(),
+		Log:                 trimmedLog,
+		Map:                 trimmedMap,
+		Vrf:                 vrfPublicPB,
+		MinInterval:         in.MinInterval,
+		MaxInterval:         in.MaxInterval,
+		Mutator:             in.GetMutator(),
+		AllowReregistration: in.GetAllowReregistration(),
+		AdminKeyset:         in.GetAdminKeyset(),
 	}
 	glog.Infof("Created directory: %+v", d)
 	return d, nil
@@ -454,3 +479,62 @@ func (s *Server) GarbageCollect(ctx context.Context, in *pb.GarbageCollectReques
 
 	return &pb.GarbageCollectResponse{Directories: deleted}, nil
 }
+
+// OverrideEntry queues an administrative override of a user's entry. The
+// override must be signed by the admin keyset of the directory. It is applied
+// regardless of the keys authorized by the user's current entry, and it is
+// tagged as an override in ListMutations so that monitors and the user can see
+// that it happened.
+func (s *Server) OverrideEntry(ctx context.Context, in *pb.OverrideEntryRequest) (*empty.Empty, error) {
+	d, err := s.directories.Read(ctx, in.GetDirectoryId(), false)
+	if st := status.Convert(err); st.Code() != codes.OK {
+		return nil, status.Errorf(st.Code(), "adminserver: directories.Read(%v): %v", in.GetDirectoryId(), st.Message())
+	}
+	var signed pb.SignedEntry
+	if err := proto.Unmarshal(in.GetSignedEntry(), &signed); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "adminserver: proto.Unmarshal(signed_entry): %v", err)
+	}
+	if !entry.IsAdminOverride(&signed) {
+		return nil, status.Errorf(codes.InvalidArgument, "adminserver: entry is not marked as an admin override")
+	}
+	if err := entry.IsValidEntry(&signed); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "adminserver: invalid entry: %v", err)
+	}
+	if err := entry.VerifyAdminOverride(&signed, d.AdminKeyset); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "adminserver: override not signed by the admin keyset: %v", err)
+	}
+
+	// Verify the index and the commitment, as the keyserver does for users.
+	var e pb.Entry
+	if err := proto.Unmarshal(signed.GetEntry(), &e); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "adminserver: proto.Unmarshal(entry): %v", err)
+	}
+	vrfPriv, err := p256.NewFromWrappedKey(ctx, d.VRFPriv)
+	if err != nil {
+		return nil, err
+	}
+	if index, _ := vrfPriv.Evaluate([]byte(in.GetUserId())); !bytes.Equal(e.GetIndex(), index[:]) {
+		return nil, status.Errorf(codes.InvalidArgument, "adminserver: entry index does not match user %v", in.GetUserId())
+	}
+	update := &pb.EntryUpdate{UserId: in.GetUserId(), Mutation: &signed}
+	if !e.GetDeleted() {
+		if err := commitments.Verify(in.GetUserId(), e.GetCommitment(), in.GetCommittedData(), in.GetCommittedKey()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "adminserver: commitments.Verify(): %v", err)
+		}
+		update.Committed = &pb.Committed{Key: in.GetCommittedKey(), Data: in.GetCommittedData()}
+	}
+
+	logIDs, err := s.logsAdmin.ListLogs(ctx, d.DirectoryID, true)
+	if st := status.Convert(err); st.Code() != codes.OK {
+		return nil, status.Errorf(st.Code(), "adminserver: ListLogs(): %v", st.Message())
+	}
+	if len(logIDs) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "adminserver: no writable logs for %v", d.DirectoryID)
+	}
+	logID := logIDs[rand.Intn(len(logIDs))]
+	if _, err := s.logsAdmin.SendBatch(ctx, d.DirectoryID, logID, []*pb.EntryUpdate{update}); err != nil {
+		return nil, status.Errorf(status.Code(err), "adminserver: SendBatch(): %v", err)
+	}
+	glog.Infof("Queued admin override of %v/%v in log %v", d.DirectoryID, in.GetUserId(), logID)
+	return &empty.Empty{}, nil
+}
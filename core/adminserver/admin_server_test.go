@@ -25,7 +25,9 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/keytransparency/core/directory"
 	"github.com/google/keytransparency/core/fake"
+	"github.com/google/keytransparency/core/mutator/entry"
 	spb "github.com/google/keytransparency/core/sequencer/sequencer_go_proto"
+	"github.com/google/keytransparency/core/water"
 	"github.com/google/trillian/crypto/keys/der"
 	"github.com/google/trillian/crypto/keyspb"
 	"github.com/google/trillian/storage/testdb"
@@ -85,6 +87,9 @@ type fakeQueueAdmin struct{}
 func (fakeQueueAdmin) AddLogs(_ context.Context, _ string, _ ...int64) error          { return nil }
 func (fakeQueueAdmin) SetWritable(_ context.Context, _ string, _ int64, _ bool) error { return nil }
 func (fakeQueueAdmin) ListLogs(_ context.Context, _ string, _ bool) ([]int64, error)  { return nil, nil }
+func (fakeQueueAdmin) SendBatch(_ context.Context, _ string, _ int64, _ []*pb.EntryUpdate) (water.Mark, error) {
+	return water.Mark{}, nil
+}
 
 type fakeBatcher struct{}
 
@@ -183,17 +188,25 @@ func TestCreateRead(t *testing.T) {
 	for _, tc := range []struct {
 		directoryID              string
 		minInterval, maxInterval time.Duration
+		mutator                  string
 	}{
 		{
 			directoryID: "testdirectory",
 			minInterval: 1 * time.Second,
 			maxInterval: 5 * time.Second,
 		},
+		{
+			directoryID: "firstwritewins",
+			minInterval: 1 * time.Second,
+			maxInterval: 5 * time.Second,
+			mutator:     entry.FirstWriteWinsMutator,
+		},
 	} {
 		_, err := svr.CreateDirectory(ctx, &pb.CreateDirectoryRequest{
 			DirectoryId: tc.directoryID,
 			MinInterval: ptypes.DurationProto(tc.minInterval),
 			MaxInterval: ptypes.DurationProto(tc.maxInterval),
+			Mutator:     tc.mutator,
 		})
 		if err != nil {
 			t.Fatalf("CreateDirectory(): %v", err)
@@ -208,6 +221,65 @@ func TestCreateRead(t *testing.T) {
 		if got, want := directory.Map.TreeType, tpb.TreeType_MAP; got != want {
 			t.Errorf("Map.TreeType: %v, want %v", got, want)
 		}
+		if got, want := directory.Mutator, tc.mutator; got != want {
+			t.Errorf("Mutator: %q, want %q", got, want)
+		}
+	}
+}
+
+func TestCreateUnknownMutator(t *testing.T) {
+	ctx := context.Background()
+	svr := New(nil, nil, nil, nil, fake.NewDirectoryStorage(), fakeQueueAdmin{}, fakeBatcher{}, vrfKeyGen)
+	_, err := svr.CreateDirectory(ctx, &pb.CreateDirectoryRequest{
+		DirectoryId: "testdirectory",
+		Mutator:     "unknown",
+	})
+	if got, want := status.Code(err), codes.InvalidArgument; got != want {
+		t.Errorf("CreateDirectory(): %v, want %v", err, want)
+	}
+}
+
+func TestOverrideEntryRejected(t *testing.T) {
+	ctx := context.Background()
+	storage := fake.NewDirectoryStorage()
+	if err := storage.Write(ctx, &directory.Directory{DirectoryID: "noadmin"}); err != nil {
+		t.Fatalf("Write(): %v", err)
+	}
+	svr := New(nil, nil, nil, nil, storage, fakeQueueAdmin{}, fakeBatcher{}, vrfKeyGen)
+	marshal := func(e *pb.Entry) []byte {
+		entryData, err := proto.Marshal(e)
+		if err != nil {
+			t.Fatalf("proto.Marshal(): %v", err)
+		}
+		signedData, err := proto.Marshal(&pb.SignedEntry{Entry: entryData})
+		if err != nil {
+			t.Fatalf("proto.Marshal(): %v", err)
+		}
+		return signedData
+	}
+
+	for _, tc := range []struct {
+		desc        string
+		directoryID string
+		signedEntry []byte
+		want        codes.Code
+	}{
+		{desc: "unknown directory", directoryID: "unknown", want: codes.NotFound},
+		{desc: "not marked", directoryID: "noadmin",
+			signedEntry: marshal(&pb.Entry{Deleted: true}), want: codes.InvalidArgument},
+		{desc: "no admin keyset", directoryID: "noadmin",
+			signedEntry: marshal(&pb.Entry{Deleted: true, AdminOverride: true}), want: codes.PermissionDenied},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := svr.OverrideEntry(ctx, &pb.OverrideEntryRequest{
+				DirectoryId: tc.directoryID,
+				UserId:      "alice",
+				SignedEntry: tc.signedEntry,
+			})
+			if got := status.Code(err); got != tc.want {
+				t.Errorf("OverrideEntry(): %v, want %v", err, tc.want)
+			}
+		})
 	}
 }
 
@@ -139,6 +139,9 @@ type State struct {
 	// errors contains a list of errors representing the verification checks
 	// that failed while monitoring the key-transparency server.
 	Errors []*status.Status `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	// server_smr contains the map root as it was signed by the key-transparency
+	// server. It is set whether or not the monitor's checks were successful.
+	ServerSmr *trillian.SignedMapRoot `protobuf:"bytes,4,opt,name=server_smr,json=serverSmr,proto3" json:"server_smr,omitempty"`
 }
 
 func (x *State) Reset() {
@@ -194,6 +197,220 @@ func (x *State) GetErrors() []*status.Status {
 	return nil
 }
 
+func (x *State) GetServerSmr() *trillian.SignedMapRoot {
+	if x != nil {
+		return x.ServerSmr
+	}
+	return nil
+}
+
+// GossipRequest submits a signed map root that a peer, such as another monitor
+// or a client, observed from a keytransparency directory.
+type GossipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kt_url is the URL of the keytransparency server the map root came from.
+	KtUrl string `protobuf:"bytes,1,opt,name=kt_url,json=ktUrl,proto3" json:"kt_url,omitempty"`
+	// directory_id identifies the merkle tree the map root belongs to.
+	DirectoryId string `protobuf:"bytes,2,opt,name=directory_id,json=directoryId,proto3" json:"directory_id,omitempty"`
+	// smr is the map root observed by the peer, signed by the
+	// key-transparency server.
+	Smr *trillian.SignedMapRoot `protobuf:"bytes,3,opt,name=smr,proto3" json:"smr,omitempty"`
+}
+
+func (x *GossipRequest) Reset() {
+	*x = GossipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_monitor_v1_monitor_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipRequest) ProtoMessage() {}
+
+func (x *GossipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_monitor_v1_monitor_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipRequest.ProtoReflect.Descriptor instead.
+func (*GossipRequest) Descriptor() ([]byte, []int) {
+	return file_monitor_v1_monitor_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GossipRequest) GetKtUrl() string {
+	if x != nil {
+		return x.KtUrl
+	}
+	return ""
+}
+
+func (x *GossipRequest) GetDirectoryId() string {
+	if x != nil {
+		return x.DirectoryId
+	}
+	return ""
+}
+
+func (x *GossipRequest) GetSmr() *trillian.SignedMapRoot {
+	if x != nil {
+		return x.Smr
+	}
+	return nil
+}
+
+// GossipResponse contains the result of comparing a gossiped map root with
+// the map root the monitor observed for the same revision.
+type GossipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// equivocation is set if the gossiped map root differs from the map root
+	// the monitor observed for the same revision.
+	Equivocation *Equivocation `protobuf:"bytes,1,opt,name=equivocation,proto3" json:"equivocation,omitempty"`
+}
+
+func (x *GossipResponse) Reset() {
+	*x = GossipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_monitor_v1_monitor_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GossipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GossipResponse) ProtoMessage() {}
+
+func (x *GossipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_monitor_v1_monitor_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GossipResponse.ProtoReflect.Descriptor instead.
+func (*GossipResponse) Descriptor() ([]byte, []int) {
+	return file_monitor_v1_monitor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GossipResponse) GetEquivocation() *Equivocation {
+	if x != nil {
+		return x.Equivocation
+	}
+	return nil
+}
+
+// Equivocation is proof that a keytransparency server presented different map
+// roots for the same revision to different observers. Both map roots are
+// signed by the key-transparency server.
+type Equivocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// kt_url is the URL of the keytransparency server that equivocated.
+	KtUrl string `protobuf:"bytes,1,opt,name=kt_url,json=ktUrl,proto3" json:"kt_url,omitempty"`
+	// directory_id identifies the merkle tree that was forked.
+	DirectoryId string `protobuf:"bytes,2,opt,name=directory_id,json=directoryId,proto3" json:"directory_id,omitempty"`
+	// revision is the map revision for which conflicting map roots were signed.
+	Revision int64 `protobuf:"varint,3,opt,name=revision,proto3" json:"revision,omitempty"`
+	// observed_smr is the map root the monitor observed.
+	ObservedSmr *trillian.SignedMapRoot `protobuf:"bytes,4,opt,name=observed_smr,json=observedSmr,proto3" json:"observed_smr,omitempty"`
+	// gossiped_smr is the conflicting map root submitted by a peer.
+	GossipedSmr *trillian.SignedMapRoot `protobuf:"bytes,5,opt,name=gossiped_smr,json=gossipedSmr,proto3" json:"gossiped_smr,omitempty"`
+}
+
+func (x *Equivocation) Reset() {
+	*x = Equivocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_monitor_v1_monitor_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Equivocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Equivocation) ProtoMessage() {}
+
+func (x *Equivocation) ProtoReflect() protoreflect.Message {
+	mi := &file_monitor_v1_monitor_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Equivocation.ProtoReflect.Descriptor instead.
+func (*Equivocation) Descriptor() ([]byte, []int) {
+	return file_monitor_v1_monitor_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Equivocation) GetKtUrl() string {
+	if x != nil {
+		return x.KtUrl
+	}
+	return ""
+}
+
+func (x *Equivocation) GetDirectoryId() string {
+	if x != nil {
+		return x.DirectoryId
+	}
+	return ""
+}
+
+func (x *Equivocation) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *Equivocation) GetObservedSmr() *trillian.SignedMapRoot {
+	if x != nil {
+		return x.ObservedSmr
+	}
+	return nil
+}
+
+func (x *Equivocation) GetGossipedSmr() *trillian.SignedMapRoot {
+	if x != nil {
+		return x.GossipedSmr
+	}
+	return nil
+}
+
 var File_monitor_v1_monitor_proto protoreflect.FileDescriptor
 
 var file_monitor_v1_monitor_proto_rawDesc = []byte{
@@ -213,7 +430,7 @@ var file_monitor_v1_monitor_proto_rawDesc = []byte{
 	0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
 	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x97,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcf,
 	0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x03, 0x73, 0x6d, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x69, 0x6c, 0x6c, 0x69, 0x61, 0x6e,
 	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x03,
@@ -223,37 +440,80 @@ var file_monitor_v1_monitor_proto_rawDesc = []byte{
 	0x6d, 0x70, 0x52, 0x08, 0x73, 0x65, 0x65, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x06,
 	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x32, 0x8b, 0x03, 0x0a, 0x07, 0x4d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x12, 0xb7, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x32, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
-	0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b,
-	0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x6d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22,
-	0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x12, 0x45, 0x2f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
-	0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6b, 0x74,
-	0x5f, 0x75, 0x72, 0x6c, 0x7d, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65,
-	0x73, 0x2f, 0x7b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x7d,
-	0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0xc5,
-	0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x79, 0x52, 0x65, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b,
+	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x73, 0x6d, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
+	0x72, 0x69, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4d, 0x61,
+	0x70, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x6d, 0x72,
+	0x22, 0x74, 0x0a, 0x0d, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6b, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x03, 0x73,
+	0x6d, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x69, 0x6c, 0x6c,
+	0x69, 0x61, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x52, 0x6f, 0x6f,
+	0x74, 0x52, 0x03, 0x73, 0x6d, 0x72, 0x22, 0x65, 0x0a, 0x0e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0c, 0x65, 0x71, 0x75, 0x69,
+	0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x71, 0x75, 0x69, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0c, 0x65, 0x71, 0x75, 0x69, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xdc, 0x01,
+	0x0a, 0x0c, 0x45, 0x71, 0x75, 0x69, 0x76, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15,
+	0x0a, 0x06, 0x6b, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6b, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64,
+	0x5f, 0x73, 0x6d, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x69,
+	0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x52,
+	0x6f, 0x6f, 0x74, 0x52, 0x0b, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x53, 0x6d, 0x72,
+	0x12, 0x3a, 0x0a, 0x0c, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x65, 0x64, 0x5f, 0x73, 0x6d, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x69, 0x6c, 0x6c, 0x69, 0x61,
+	0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x52, 0x6f, 0x6f, 0x74, 0x52,
+	0x0b, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x65, 0x64, 0x53, 0x6d, 0x72, 0x32, 0xc6, 0x04, 0x0a,
+	0x07, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0xb7, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b,
 	0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x6d,
 	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
 	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e,
 	0x63, 0x79, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x22, 0x51, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x12, 0x49, 0x2f, 0x6d, 0x6f,
+	0x61, 0x74, 0x65, 0x22, 0x4d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x47, 0x12, 0x45, 0x2f, 0x6d, 0x6f,
 	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
 	0x2f, 0x7b, 0x6b, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x7d, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
 	0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x2f, 0x7b, 0x72, 0x65, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x6b, 0x65, 0x79, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f,
-	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x67, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x12, 0xc5, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42,
+	0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x63, 0x79, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x51, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x12,
+	0x49, 0x2f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6b, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x7d, 0x2f, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x2f,
+	0x7b, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x7d, 0x12, 0xb8, 0x01, 0x0a, 0x06, 0x47,
+	0x6f, 0x73, 0x73, 0x69, 0x70, 0x12, 0x30, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b,
+	0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x6f, 0x73, 0x73,
+	0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x43, 0x22, 0x3e, 0x2f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x6b, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x7d,
+	0x2f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x67, 0x6f, 0x73, 0x73,
+	0x69, 0x70, 0x3a, 0x01, 0x2a, 0x42, 0x48, 0x5a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x6b, 0x65, 0x79, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x5f, 0x67, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -268,27 +528,37 @@ func file_monitor_v1_monitor_proto_rawDescGZIP() []byte {
 	return file_monitor_v1_monitor_proto_rawDescData
 }
 
-var file_monitor_v1_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_monitor_v1_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_monitor_v1_monitor_proto_goTypes = []interface{}{
 	(*GetStateRequest)(nil),        // 0: google.keytransparency.monitor.v1.GetStateRequest
 	(*State)(nil),                  // 1: google.keytransparency.monitor.v1.State
-	(*trillian.SignedMapRoot)(nil), // 2: trillian.SignedMapRoot
-	(*timestamp.Timestamp)(nil),    // 3: google.protobuf.Timestamp
-	(*status.Status)(nil),          // 4: google.rpc.Status
+	(*GossipRequest)(nil),          // 2: google.keytransparency.monitor.v1.GossipRequest
+	(*GossipResponse)(nil),         // 3: google.keytransparency.monitor.v1.GossipResponse
+	(*Equivocation)(nil),           // 4: google.keytransparency.monitor.v1.Equivocation
+	(*trillian.SignedMapRoot)(nil), // 5: trillian.SignedMapRoot
+	(*timestamp.Timestamp)(nil),    // 6: google.protobuf.Timestamp
+	(*status.Status)(nil),          // 7: google.rpc.Status
 }
 var file_monitor_v1_monitor_proto_depIdxs = []int32{
-	2, // 0: google.keytransparency.monitor.v1.State.smr:type_name -> trillian.SignedMapRoot
-	3, // 1: google.keytransparency.monitor.v1.State.seen_time:type_name -> google.protobuf.Timestamp
-	4, // 2: google.keytransparency.monitor.v1.State.errors:type_name -> google.rpc.Status
-	0, // 3: google.keytransparency.monitor.v1.Monitor.GetState:input_type -> google.keytransparency.monitor.v1.GetStateRequest
-	0, // 4: google.keytransparency.monitor.v1.Monitor.GetStateByRevision:input_type -> google.keytransparency.monitor.v1.GetStateRequest
-	1, // 5: google.keytransparency.monitor.v1.Monitor.GetState:output_type -> google.keytransparency.monitor.v1.State
-	1, // 6: google.keytransparency.monitor.v1.Monitor.GetStateByRevision:output_type -> google.keytransparency.monitor.v1.State
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	5,  // 0: google.keytransparency.monitor.v1.State.smr:type_name -> trillian.SignedMapRoot
+	6,  // 1: google.keytransparency.monitor.v1.State.seen_time:type_name -> google.protobuf.Timestamp
+	7,  // 2: google.keytransparency.monitor.v1.State.errors:type_name -> google.rpc.Status
+	5,  // 3: google.keytransparency.monitor.v1.State.server_smr:type_name -> trillian.SignedMapRoot
+	5,  // 4: google.keytransparency.monitor.v1.GossipRequest.smr:type_name -> trillian.SignedMapRoot
+	4,  // 5: google.keytransparency.monitor.v1.GossipResponse.equivocation:type_name -> google.keytransparency.monitor.v1.Equivocation
+	5,  // 6: google.keytransparency.monitor.v1.Equivocation.observed_smr:type_name -> trillian.SignedMapRoot
+	5,  // 7: google.keytransparency.monitor.v1.Equivocation.gossiped_smr:type_name -> trillian.SignedMapRoot
+	0,  // 8: google.keytransparency.monitor.v1.Monitor.GetState:input_type -> google.keytransparency.monitor.v1.GetStateRequest
+	0,  // 9: google.keytransparency.monitor.v1.Monitor.GetStateByRevision:input_type -> google.keytransparency.monitor.v1.GetStateRequest
+	2,  // 10: google.keytransparency.monitor.v1.Monitor.Gossip:input_type -> google.keytransparency.monitor.v1.GossipRequest
+	1,  // 11: google.keytransparency.monitor.v1.Monitor.GetState:output_type -> google.keytransparency.monitor.v1.State
+	1,  // 12: google.keytransparency.monitor.v1.Monitor.GetStateByRevision:output_type -> google.keytransparency.monitor.v1.State
+	3,  // 13: google.keytransparency.monitor.v1.Monitor.Gossip:output_type -> google.keytransparency.monitor.v1.GossipResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_monitor_v1_monitor_proto_init() }
@@ -321,6 +591,42 @@ func file_monitor_v1_monitor_proto_init() {
 				return nil
 			}
 		}
+		file_monitor_v1_monitor_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_monitor_v1_monitor_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GossipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_monitor_v1_monitor_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Equivocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -328,7 +634,7 @@ func file_monitor_v1_monitor_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_monitor_v1_monitor_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -371,6 +677,12 @@ type MonitorClient interface {
 	// mutations from the previous to the current revision it won't sign the map
 	// root and additional data will be provided to reproduce the failure.
 	GetStateByRevision(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*State, error)
+	// Gossip compares a map root observed by a peer with the map root the
+	// monitor observed for the same revision.
+	//
+	// If the map roots differ, the monitor records and returns an equivocation
+	// proof. Returns NotFound if the monitor has not yet processed the revision.
+	Gossip(ctx context.Context, in *GossipRequest, opts ...grpc.CallOption) (*GossipResponse, error)
 }
 
 type monitorClient struct {
@@ -399,6 +711,15 @@ func (c *monitorClient) GetStateByRevision(ctx context.Context, in *GetStateRequ
 	return out, nil
 }
 
+func (c *monitorClient) Gossip(ctx context.Context, in *GossipRequest, opts ...grpc.CallOption) (*GossipResponse, error) {
+	out := new(GossipResponse)
+	err := c.cc.Invoke(ctx, "/google.keytransparency.monitor.v1.Monitor/Gossip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MonitorServer is the server API for Monitor service.
 type MonitorServer interface {
 	// GetSignedMapRoot returns the latest valid signed map root the monitor
@@ -418,6 +739,12 @@ type MonitorServer interface {
 	// mutations from the previous to the current revision it won't sign the map
 	// root and additional data will be provided to reproduce the failure.
 	GetStateByRevision(context.Context, *GetStateRequest) (*State, error)
+	// Gossip compares a map root observed by a peer with the map root the
+	// monitor observed for the same revision.
+	//
+	// If the map roots differ, the monitor records and returns an equivocation
+	// proof. Returns NotFound if the monitor has not yet processed the revision.
+	Gossip(context.Context, *GossipRequest) (*GossipResponse, error)
 }
 
 // UnimplementedMonitorServer can be embedded to have forward compatible implementations.
@@ -430,6 +757,9 @@ func (*UnimplementedMonitorServer) GetState(context.Context, *GetStateRequest) (
 func (*UnimplementedMonitorServer) GetStateByRevision(context.Context, *GetStateRequest) (*State, error) {
 	return nil, status1.Errorf(codes.Unimplemented, "method GetStateByRevision not implemented")
 }
+func (*UnimplementedMonitorServer) Gossip(context.Context, *GossipRequest) (*GossipResponse, error) {
+	return nil, status1.Errorf(codes.Unimplemented, "method Gossip not implemented")
+}
 
 func RegisterMonitorServer(s *grpc.Server, srv MonitorServer) {
 	s.RegisterService(&_Monitor_serviceDesc, srv)
@@ -471,6 +801,24 @@ func _Monitor_GetStateByRevision_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Monitor_Gossip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GossipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MonitorServer).Gossip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.keytransparency.monitor.v1.Monitor/Gossip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MonitorServer).Gossip(ctx, req.(*GossipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Monitor_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "google.keytransparency.monitor.v1.Monitor",
 	HandlerType: (*MonitorServer)(nil),
@@ -483,6 +831,10 @@ var _Monitor_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetStateByRevision",
 			Handler:    _Monitor_GetStateByRevision_Handler,
 		},
+		{
+			MethodName: "Gossip",
+			Handler:    _Monitor_Gossip_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "monitor/v1/monitor.proto",
@@ -155,5 +155,8 @@ func (c *Client) createMutation(smr *types.MapRootV1, leaf *pb.MapLeaf, u *User)
 			return nil, err
 		}
 	}
+	if err := setValidityPeriod(mutation, u); err != nil {
+		return nil, err
+	}
 	return mutation, nil
 }
@@ -119,6 +119,9 @@ func (c *Client) BatchVerifiedGetUser(ctx context.Context, userIDs []string) (
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := c.VerifyFreshness(smr); err != nil {
+		return nil, nil, err
+	}
 
 	leavesByUserID := make(map[string]*pb.MapLeaf)
 	for userID, leaf := range resp.MapLeavesByUserId {
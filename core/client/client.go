@@ -42,8 +42,6 @@ import (
 	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
 )
 
-// TODO: Public keys of trusted monitors.
-
 var (
 	// ErrRetry occurs when an update has been queued, but the
 	// results of the update differ from the one requested.
@@ -54,6 +52,12 @@ var (
 	// ErrWait occurs when an update has been queued, but no change has been
 	// observed in the user's account yet.
 	ErrWait = status.Errorf(codes.Unavailable, "client: update not present yet - wait some more")
+	// ErrRecoveryPending occurs when an update signed by a recovery key has
+	// been accepted, but cannot be completed until the recovery delay has
+	// passed. The update must be resubmitted after the delay.
+	ErrRecoveryPending = errors.New("client: recovery pending - resubmit after the recovery delay")
+	// ErrDeleted occurs when a user's entry has been replaced by a tombstone.
+	ErrDeleted = errors.New("client: user has been deleted")
 	// ErrIncomplete occurs when the server indicates that requested revisions
 	// are not available.
 	ErrIncomplete = errors.New("incomplete account history")
@@ -73,6 +77,11 @@ type VerifierInterface interface {
 	Index(vrfProof []byte, directoryID, userID string) ([]byte, error)
 	// VerifyMapRevision verifies that the map revision is correctly signed and included in the log.
 	VerifyMapRevision(lr *types.LogRootV1, smr *pb.MapRoot) (*types.MapRootV1, error)
+	// RequireFreshness makes VerifyFreshness reject map roots that are older
+	// than maxInterval plus skew.
+	RequireFreshness(maxInterval, skew time.Duration)
+	// VerifyFreshness verifies that the latest map root is recent enough.
+	VerifyFreshness(mapRoot *types.MapRootV1) error
 	// VerifyMapLeaf verifies everything about a MapLeaf.
 	VerifyMapLeaf(directoryID, userID string, in *pb.MapLeaf, smr *types.MapRootV1) error
 	//
@@ -85,14 +94,6 @@ type VerifierInterface interface {
 	VerifyBatchGetUser(req *pb.BatchGetUserRequest, resp *pb.BatchGetUserResponse) error
 }
 
-// ReduceMutationFn takes all the mutations for an index and an auxiliary input
-// of existing mapleaf(s) returns a new map leaf.  ReduceMutationFn must be
-// idempotent, commutative, and associative. i.e. must produce the same output
-// regardless of input order or grouping, and it must be safe to run multiple
-// times.
-type ReduceMutationFn func(msgs []*pb.EntryUpdate, leaves []*pb.EntryUpdate,
-	emit func(*pb.EntryUpdate), emitErr func(error))
-
 // Client is a helper library for issuing updates to the key server.
 // Client Responsibilities
 // - Trust Model:
@@ -109,8 +110,10 @@ type Client struct {
 	VerifierInterface
 	cli         pb.KeyTransparencyClient
 	DirectoryID string
-	reduce      ReduceMutationFn
 	RetryDelay  time.Duration
+	// Monitors, if set, must sign the map roots returned by VerifiedGetUser
+	// and VerifiedGetLatestRevision.
+	Monitors *MonitorQuorum
 }
 
 // NewFromConfig creates a new client from a config
@@ -137,16 +140,26 @@ func New(ktClient pb.KeyTransparencyClient,
 		VerifierInterface: ktVerifier,
 		cli:               ktClient,
 		DirectoryID:       directoryID,
-		reduce:            entry.ReduceFn,
 		RetryDelay:        retryDelay,
 	}
 }
 
 // GetUser returns an entry if it exists, and nil if it does not.
+// GetUser returns ErrDeleted if the entry has been deleted.
 func (c *Client) GetUser(ctx context.Context, userID string, opts ...grpc.CallOption) (
 	*types.MapRootV1, []byte, error) {
 	smr, e, err := c.VerifiedGetUser(ctx, userID)
-	return smr, e.GetCommitted().GetData(), err
+	if err != nil {
+		return smr, nil, err
+	}
+	signed, err := entry.FromLeafValue(e.GetMapInclusion().GetLeaf().GetLeafValue())
+	if err != nil {
+		return smr, nil, err
+	}
+	if entry.IsDeleted(signed) {
+		return smr, nil, ErrDeleted
+	}
+	return smr, e.GetCommitted().GetData(), nil
 }
 
 // PaginateHistory iteratively calls ListHistory to satisfy the start and end requirements.
@@ -235,12 +248,31 @@ func (c *Client) Update(ctx context.Context, u *User, signers []tink.Signer, opt
 		return nil, err
 	}
 
-	// 2. Queue Mutation.
-	if err := c.QueueMutation(ctx, m, signers, opts...); err != nil {
+	// 2. Queue Mutation and wait for update.
+	return c.UpdateMutation(ctx, m, signers, opts...)
+}
+
+// Delete replaces a user's entry with a tombstone and waits for it to appear.
+// The tombstone must be signed by the user's current authorized keys.
+func (c *Client) Delete(ctx context.Context, userID string, signers []tink.Signer, opts ...grpc.CallOption) (*entry.Mutation, error) {
+	m, err := c.CreateMutation(ctx, &User{UserID: userID})
+	if err != nil {
 		return nil, err
 	}
+	m.Delete()
+	return c.UpdateMutation(ctx, m, signers, opts...)
+}
 
-	// 3. Wait for update.
+// UpdateMutation submits a mutation created with CreateMutation and waits for
+// it to appear. When the current entry requires signatures from several key
+// holders, they can be collected over multiple rounds before calling
+// UpdateMutation: sign with m.Sign, pass the partially signed entry to each
+// key holder to sign with entry.CoSign, and add the result with
+// m.AddSignatures.
+func (c *Client) UpdateMutation(ctx context.Context, m *entry.Mutation, signers []tink.Signer, opts ...grpc.CallOption) (*entry.Mutation, error) {
+	if err := c.QueueMutation(ctx, m, signers, opts...); err != nil {
+		return nil, err
+	}
 	return c.WaitForUserUpdate(ctx, m)
 }
 
@@ -285,11 +317,31 @@ func (c *Client) CreateMutation(ctx context.Context, u *User) (*entry.Mutation,
 		if err := mutation.ReplaceAuthorizedKeys(u.AuthorizedKeys); err != nil {
 			return nil, err
 		}
+		mutation.SetSignatureThreshold(u.SignatureThreshold)
+	}
+
+	if u.RecoveryKeys != nil {
+		if err := mutation.SetRecoveryKeys(u.RecoveryKeys, u.RecoveryDelay); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := setValidityPeriod(mutation, u); err != nil {
+		return nil, err
 	}
 
 	return mutation, nil
 }
 
+// setValidityPeriod replaces or clears the validity period that SetPrevious
+// copied into m, if u asks for it.
+func setValidityPeriod(m *entry.Mutation, u *User) error {
+	if u.NotBefore.IsZero() && u.NotAfter.IsZero() && !u.ClearValidityPeriod {
+		return nil
+	}
+	return m.SetValidityPeriod(u.NotBefore, u.NotAfter)
+}
+
 // WaitForUserUpdate waits for the mutation to be applied or the context to timeout or cancel.
 func (c *Client) WaitForUserUpdate(ctx context.Context, m *entry.Mutation) (*entry.Mutation, error) {
 	for {
@@ -340,6 +392,8 @@ func (c *Client) waitOnceForUserUpdate(ctx context.Context, m *entry.Mutation) (
 		return nil, nil
 	case m.EqualsPrevious(cntValue):
 		return m, ErrWait
+	case m.IsPendingRecovery(cntValue):
+		return m, ErrRecoveryPending
 	default:
 		// Race condition: some change got in first.
 		// Value has changed, but it's not what we asked for.
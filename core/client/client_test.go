@@ -15,12 +15,16 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/go-cmp/cmp"
 	"github.com/google/keytransparency/core/testutil"
 	"github.com/google/trillian"
 	"github.com/google/trillian/types"
@@ -217,8 +221,115 @@ func TestPaginateHistory(t *testing.T) {
 	}
 }
 
+func TestStreamRevisions(t *testing.T) {
+	srv := &fakeKeyServer{
+		revisions: map[int64]*pb.GetUserResponse{
+			0: {Revision: &pb.Revision{MapRoot: &pb.MapRoot{MapRoot: &trillian.SignedMapRoot{MapRoot: []byte{0}}}}},
+			1: {Revision: &pb.Revision{MapRoot: &pb.MapRoot{MapRoot: &trillian.SignedMapRoot{MapRoot: []byte{1}}}}},
+			2: {Revision: &pb.Revision{MapRoot: &pb.MapRoot{MapRoot: &trillian.SignedMapRoot{MapRoot: []byte{2}}}}},
+			3: {Revision: &pb.Revision{MapRoot: &pb.MapRoot{MapRoot: &trillian.SignedMapRoot{MapRoot: []byte{3}}}}},
+		},
+	}
+	for _, tc := range []struct {
+		desc string
+		srv  pb.KeyTransparencyServer
+	}{
+		{desc: "stream", srv: srv},
+		{desc: "poll", srv: &unaryKeyServer{srv}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+			s, stop, err := testutil.NewFakeKT(tc.srv)
+			if err != nil {
+				t.Fatalf("NewFakeKT(): %v", err)
+			}
+			defer stop()
+			c := Client{
+				VerifierInterface: &fakeVerifier{},
+				cli:               s.Client,
+				RetryDelay:        time.Millisecond,
+			}
+
+			out := make(chan *MapRevision)
+			errc := make(chan error, 1)
+			go func() { errc <- c.StreamRevisions(ctx, 1, out) }()
+			for want := uint64(1); want < 4; want++ {
+				mr, ok := <-out
+				if !ok {
+					t.Fatalf("StreamRevisions(): %v", <-errc)
+				}
+				if got := mr.Revision; got != want {
+					t.Errorf("StreamRevisions(): revision %v, want %v", got, want)
+				}
+				if got := mr.Signed.GetMapRoot(); !bytes.Equal(got, []byte{byte(want)}) {
+					t.Errorf("StreamRevisions(): signed map root %x, want %x", got, []byte{byte(want)})
+				}
+			}
+			cancel()
+			for range out {
+			}
+			if err := <-errc; err != context.Canceled && status.Code(err) != codes.Canceled {
+				t.Errorf("StreamRevisions(): %v, want %v", err, context.Canceled)
+			}
+		})
+	}
+}
+
+func TestRevisionMutations(t *testing.T) {
+	srv := &fakeKeyServer{}
+	for i := 0; i < 5; i++ {
+		srv.mutations = append(srv.mutations, &pb.MutationProof{
+			Mutation: &pb.SignedEntry{Entry: []byte{byte(i)}},
+		})
+	}
+	for _, tc := range []struct {
+		desc string
+		srv  pb.KeyTransparencyServer
+	}{
+		{desc: "stream", srv: srv},
+		{desc: "paginate", srv: &unaryKeyServer{srv}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+			defer cancel()
+			s, stop, err := testutil.NewFakeKT(tc.srv)
+			if err != nil {
+				t.Fatalf("NewFakeKT(): %v", err)
+			}
+			defer stop()
+			c := Client{
+				VerifierInterface: &fakeVerifier{},
+				cli:               s.Client,
+			}
+
+			got, err := c.RevisionMutations(ctx, &types.MapRootV1{Revision: 1})
+			if err != nil {
+				t.Fatalf("RevisionMutations(): %v", err)
+			}
+			if !cmp.Equal(got, srv.mutations, cmp.Comparer(proto.Equal)) {
+				t.Errorf("RevisionMutations(): %v, want %v", got, srv.mutations)
+			}
+		})
+	}
+}
+
+// unaryKeyServer does not support streaming APIs.
+type unaryKeyServer struct {
+	*fakeKeyServer
+}
+
+func (*unaryKeyServer) GetRevisionStream(*pb.GetRevisionRequest, pb.KeyTransparency_GetRevisionStreamServer) error {
+	return status.Error(codes.Unimplemented, "not implemented")
+}
+
+func (*unaryKeyServer) ListMutationsStream(*pb.ListMutationsRequest, pb.KeyTransparency_ListMutationsStreamServer) error {
+	return status.Error(codes.Unimplemented, "not implemented")
+}
+
 type fakeKeyServer struct {
 	revisions map[int64]*pb.GetUserResponse
+	mutations []*pb.MutationProof
 }
 
 func (f *fakeKeyServer) ListEntryHistory(ctx context.Context, in *pb.ListEntryHistoryRequest) (*pb.ListEntryHistoryResponse, error) {
@@ -259,24 +370,56 @@ func (f *fakeKeyServer) GetDirectory(context.Context, *pb.GetDirectoryRequest) (
 	return nil, status.Error(codes.Unimplemented, "not implemented")
 }
 
-func (f *fakeKeyServer) GetRevision(context.Context, *pb.GetRevisionRequest) (*pb.Revision, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+func (f *fakeKeyServer) GetRevision(ctx context.Context, in *pb.GetRevisionRequest) (*pb.Revision, error) {
+	r, ok := f.revisions[in.Revision]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "revision %v not found", in.Revision)
+	}
+	return r.Revision, nil
 }
 
 func (f *fakeKeyServer) GetLatestRevision(context.Context, *pb.GetLatestRevisionRequest) (*pb.Revision, error) {
 	return nil, status.Error(codes.Unimplemented, "not implemented")
 }
 
-func (f *fakeKeyServer) GetRevisionStream(*pb.GetRevisionRequest, pb.KeyTransparency_GetRevisionStreamServer) error {
-	return status.Error(codes.Unimplemented, "not implemented")
+func (f *fakeKeyServer) GetRevisionStream(in *pb.GetRevisionRequest, stream pb.KeyTransparency_GetRevisionStreamServer) error {
+	for i := in.Revision; i < int64(len(f.revisions)); i++ {
+		if err := stream.Send(f.revisions[i].Revision); err != nil {
+			return err
+		}
+	}
+	<-stream.Context().Done()
+	return stream.Context().Err()
 }
 
-func (f *fakeKeyServer) ListMutations(context.Context, *pb.ListMutationsRequest) (*pb.ListMutationsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+func (f *fakeKeyServer) ListMutations(ctx context.Context, in *pb.ListMutationsRequest) (*pb.ListMutationsResponse, error) {
+	pageSize := 2 // Test pagination.
+	start := 0
+	if in.PageToken != "" {
+		var err error
+		if start, err = strconv.Atoi(in.PageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+	}
+	end := start + pageSize
+	next := strconv.Itoa(end)
+	if end >= len(f.mutations) {
+		end = len(f.mutations)
+		next = ""
+	}
+	return &pb.ListMutationsResponse{
+		Mutations:     f.mutations[start:end],
+		NextPageToken: next,
+	}, nil
 }
 
-func (f *fakeKeyServer) ListMutationsStream(*pb.ListMutationsRequest, pb.KeyTransparency_ListMutationsStreamServer) error {
-	return status.Error(codes.Unimplemented, "not implemented")
+func (f *fakeKeyServer) ListMutationsStream(in *pb.ListMutationsRequest, stream pb.KeyTransparency_ListMutationsStreamServer) error {
+	for _, m := range f.mutations {
+		if err := stream.Send(m); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (f *fakeKeyServer) GetUser(context.Context, *pb.GetUserRequest) (*pb.GetUserResponse, error) {
@@ -318,6 +461,12 @@ func (f *fakeVerifier) VerifyMapRevision(logRoot *types.LogRootV1, smr *pb.MapRo
 	return &types.MapRootV1{Revision: uint64(smr.MapRoot.MapRoot[0])}, nil
 }
 
+func (f *fakeVerifier) RequireFreshness(maxInterval, skew time.Duration) {}
+
+func (f *fakeVerifier) VerifyFreshness(mapRoot *types.MapRootV1) error {
+	return nil
+}
+
 func (f *fakeVerifier) VerifyMapLeaf(directoryID, userID string,
 	in *pb.MapLeaf, smr *types.MapRootV1) error {
 	return nil
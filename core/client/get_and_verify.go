@@ -48,6 +48,12 @@ func (c *Client) VerifiedGetUser(ctx context.Context, userID string) (*types.Map
 	if err := c.VerifyMapLeaf(c.DirectoryID, userID, resp.Leaf, mr); err != nil {
 		return nil, nil, err
 	}
+	if err := c.VerifyFreshness(mr); err != nil {
+		return nil, nil, err
+	}
+	if err := c.verifyMonitors(ctx, mr); err != nil {
+		return nil, nil, err
+	}
 
 	return mr, resp.Leaf, nil
 }
@@ -83,13 +89,38 @@ func (c *Client) VerifiedGetLatestRevision(ctx context.Context) (*types.LogRootV
 	if mr.Revision != wantRevision {
 		return nil, nil, fmt.Errorf("map revision is not the most recent. smr.Revison: %v != slr.TreeSize-1: %v", mr.Revision, lr.TreeSize-1)
 	}
+	if err := c.VerifyFreshness(mr); err != nil {
+		return nil, nil, err
+	}
+	if err := c.verifyMonitors(ctx, mr); err != nil {
+		return nil, nil, err
+	}
 	return lr, mr, nil
 }
 
+// verifyMonitors checks that the trusted monitors signed mr, if the client has
+// any trusted monitors.
+func (c *Client) verifyMonitors(ctx context.Context, mr *types.MapRootV1) error {
+	if c.Monitors == nil {
+		return nil
+	}
+	return c.Monitors.VerifyMapRoot(ctx, c.DirectoryID, mr)
+}
+
 // VerifiedGetRevision fetches the requested revision from the key server.
 // It also verifies the consistency of the latest log root against the last seen log root.
 // Returns the requested map root.
 func (c *Client) VerifiedGetRevision(ctx context.Context, revision int64) (*types.MapRootV1, error) {
+	mr, err := c.verifiedGetRevision(ctx, revision)
+	if err != nil {
+		return nil, err
+	}
+	return mr.MapRootV1, nil
+}
+
+// verifiedGetRevision fetches and verifies the requested revision and returns
+// the map root along with the signed map root it was parsed from.
+func (c *Client) verifiedGetRevision(ctx context.Context, revision int64) (*MapRevision, error) {
 	logReq := c.LastVerifiedLogRoot()
 	req := &pb.GetRevisionRequest{
 		DirectoryId:  c.DirectoryID,
@@ -110,7 +141,7 @@ func (c *Client) VerifiedGetRevision(ctx context.Context, revision int64) (*type
 		return nil, err
 	}
 
-	return mr, nil
+	return &MapRevision{MapRootV1: mr, Signed: resp.GetMapRoot().GetMapRoot()}, nil
 }
 
 // VerifiedListHistory performs one list history operation, verifies and returns the results.
@@ -0,0 +1,115 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+// This file contains functions that ask trusted monitors to attest to map roots.
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/google/trillian/types"
+
+	mopb "github.com/google/keytransparency/core/api/monitor/v1/monitor_go_proto"
+	tcrypto "github.com/google/trillian/crypto"
+)
+
+var (
+	// ErrMonitorDisagreement occurs when a trusted monitor signed a different
+	// map root than the one the key server returned for the same revision.
+	ErrMonitorDisagreement = errors.New("monitor signed a different map root")
+	// ErrMonitorReportedErrors occurs when a trusted monitor failed to verify
+	// the revision.
+	ErrMonitorReportedErrors = errors.New("monitor reported errors for revision")
+	// ErrMonitorQuorum occurs when fewer than the required number of trusted
+	// monitors signed the map root.
+	ErrMonitorQuorum = errors.New("not enough monitor signatures")
+)
+
+// TrustedMonitor is a monitor whose signatures on map roots the client trusts.
+type TrustedMonitor struct {
+	// Client connects to the monitor.
+	Client mopb.MonitorClient
+	// KTURL is the URL under which the monitor tracks the key server.
+	KTURL string
+	// PublicKey verifies the monitor's signatures on map roots.
+	PublicKey crypto.PublicKey
+}
+
+// MonitorQuorum requires Threshold of Monitors to sign each map root.
+type MonitorQuorum struct {
+	Monitors  []TrustedMonitor
+	Threshold int
+}
+
+// NewMonitorQuorum returns a MonitorQuorum that requires threshold of monitors
+// to sign each map root.
+func NewMonitorQuorum(threshold int, monitors ...TrustedMonitor) (*MonitorQuorum, error) {
+	if threshold < 1 || threshold > len(monitors) {
+		return nil, fmt.Errorf("threshold %v must be between 1 and the number of monitors %v",
+			threshold, len(monitors))
+	}
+	return &MonitorQuorum{Monitors: monitors, Threshold: threshold}, nil
+}
+
+// VerifyMapRoot asks each monitor for its signature on mapRoot. It returns
+// ErrMonitorDisagreement if any monitor signed a different map root for the
+// revision, ErrMonitorReportedErrors if any monitor failed to verify the
+// revision, and ErrMonitorQuorum if fewer than Threshold monitors signed
+// mapRoot.
+func (q *MonitorQuorum) VerifyMapRoot(ctx context.Context, directoryID string, mapRoot *types.MapRootV1) error {
+	want, err := mapRoot.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	signatures := 0
+	for i, m := range q.Monitors {
+		state, err := m.Client.GetStateByRevision(ctx, &mopb.GetStateRequest{
+			KtUrl:       m.KTURL,
+			DirectoryId: directoryID,
+			Revision:    int64(mapRoot.Revision),
+		})
+		if err != nil {
+			glog.Warningf("monitor %v: GetStateByRevision(%v): %v", i, mapRoot.Revision, err)
+			continue
+		}
+		if errs := state.GetErrors(); len(errs) > 0 {
+			return fmt.Errorf("%w: monitor %v, revision %v: %v",
+				ErrMonitorReportedErrors, i, mapRoot.Revision, errs)
+		}
+		if state.GetSmr() == nil {
+			glog.Warningf("monitor %v: revision %v is not signed", i, mapRoot.Revision)
+			continue
+		}
+		if _, err := tcrypto.VerifySignedMapRoot(m.PublicKey, crypto.SHA256, state.GetSmr()); err != nil {
+			glog.Warningf("monitor %v: invalid signature on revision %v: %v", i, mapRoot.Revision, err)
+			continue
+		}
+		if !bytes.Equal(state.GetSmr().GetMapRoot(), want) {
+			return fmt.Errorf("%w: monitor %v, revision %v", ErrMonitorDisagreement, i, mapRoot.Revision)
+		}
+		signatures++
+	}
+	if signatures < q.Threshold {
+		return fmt.Errorf("%w: got %v of %v for revision %v",
+			ErrMonitorQuorum, signatures, q.Threshold, mapRoot.Revision)
+	}
+	Vlog.Printf("✓ Map root signed by %v of %v monitors.", signatures, len(q.Monitors))
+	return nil
+}
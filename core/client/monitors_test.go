@@ -0,0 +1,124 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"testing"
+
+	"github.com/google/trillian/crypto/keys"
+	"github.com/google/trillian/crypto/keyspb"
+	"github.com/google/trillian/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mopb "github.com/google/keytransparency/core/api/monitor/v1/monitor_go_proto"
+	tcrypto "github.com/google/trillian/crypto"
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+)
+
+// fakeMonitor returns the same state for every revision.
+type fakeMonitor struct {
+	mopb.MonitorClient
+	state *mopb.State
+	err   error
+}
+
+func (f *fakeMonitor) GetStateByRevision(context.Context, *mopb.GetStateRequest, ...grpc.CallOption) (*mopb.State, error) {
+	return f.state, f.err
+}
+
+func TestNewMonitorQuorum(t *testing.T) {
+	monitors := []TrustedMonitor{{}, {}}
+	for _, tc := range []struct {
+		threshold int
+		wantErr   bool
+	}{
+		{threshold: 0, wantErr: true},
+		{threshold: 1},
+		{threshold: 2},
+		{threshold: 3, wantErr: true},
+	} {
+		_, err := NewMonitorQuorum(tc.threshold, monitors...)
+		if got := err != nil; got != tc.wantErr {
+			t.Errorf("NewMonitorQuorum(%v): %v, wantErr %v", tc.threshold, err, tc.wantErr)
+		}
+	}
+}
+
+func TestMonitorQuorumVerifyMapRoot(t *testing.T) {
+	ctx := context.Background()
+	newSigner := func() *tcrypto.Signer {
+		key, err := keys.NewFromSpec(&keyspb.Specification{
+			Params: &keyspb.Specification_EcdsaParams{EcdsaParams: &keyspb.Specification_ECDSA{}},
+		})
+		if err != nil {
+			t.Fatalf("NewFromSpec(): %v", err)
+		}
+		return tcrypto.NewSigner(0, key, crypto.SHA256)
+	}
+	signer1, signer2, other := newSigner(), newSigner(), newSigner()
+
+	mapRoot := &types.MapRootV1{Revision: 3, RootHash: []byte("root")}
+	forked := &types.MapRootV1{Revision: 3, RootHash: []byte("forked")}
+	signed := func(s *tcrypto.Signer, mr *types.MapRootV1) *mopb.State {
+		smr, err := s.SignMapRoot(mr)
+		if err != nil {
+			t.Fatalf("SignMapRoot(): %v", err)
+		}
+		return &mopb.State{Smr: smr}
+	}
+	unavailable := &fakeMonitor{err: status.Errorf(codes.Unavailable, "down")}
+	failed := &fakeMonitor{state: &mopb.State{Errors: []*statuspb.Status{{Code: int32(codes.DataLoss)}}}}
+
+	for _, tc := range []struct {
+		desc      string
+		threshold int
+		monitors  []mopb.MonitorClient
+		wantErr   error
+	}{
+		{desc: "all signed", threshold: 2, monitors: []mopb.MonitorClient{
+			&fakeMonitor{state: signed(signer1, mapRoot)}, &fakeMonitor{state: signed(signer2, mapRoot)}}},
+		{desc: "k of n", threshold: 1, monitors: []mopb.MonitorClient{
+			&fakeMonitor{state: signed(signer1, mapRoot)}, unavailable}},
+		{desc: "unavailable", threshold: 2, wantErr: ErrMonitorQuorum, monitors: []mopb.MonitorClient{
+			&fakeMonitor{state: signed(signer1, mapRoot)}, unavailable}},
+		{desc: "unsigned", threshold: 2, wantErr: ErrMonitorQuorum, monitors: []mopb.MonitorClient{
+			&fakeMonitor{state: signed(signer1, mapRoot)}, &fakeMonitor{state: &mopb.State{}}}},
+		{desc: "wrong key", threshold: 2, wantErr: ErrMonitorQuorum, monitors: []mopb.MonitorClient{
+			&fakeMonitor{state: signed(signer1, mapRoot)}, &fakeMonitor{state: signed(other, mapRoot)}}},
+		{desc: "disagreement", threshold: 1, wantErr: ErrMonitorDisagreement, monitors: []mopb.MonitorClient{
+			&fakeMonitor{state: signed(signer1, mapRoot)}, &fakeMonitor{state: signed(signer2, forked)}}},
+		{desc: "monitor errors", threshold: 1, wantErr: ErrMonitorReportedErrors, monitors: []mopb.MonitorClient{
+			&fakeMonitor{state: signed(signer1, mapRoot)}, failed}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			trusted := []TrustedMonitor{
+				{Client: tc.monitors[0], PublicKey: signer1.Public()},
+				{Client: tc.monitors[1], PublicKey: signer2.Public()},
+			}
+			q, err := NewMonitorQuorum(tc.threshold, trusted...)
+			if err != nil {
+				t.Fatalf("NewMonitorQuorum(): %v", err)
+			}
+			if err := q.VerifyMapRoot(ctx, "dir", mapRoot); !errors.Is(err, tc.wantErr) {
+				t.Errorf("VerifyMapRoot(): %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
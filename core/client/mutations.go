@@ -19,9 +19,11 @@ package client
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/golang/glog"
+	"github.com/google/trillian"
 	"github.com/google/trillian/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -36,16 +38,70 @@ type RevisionMutations struct {
 	Mutations []*pb.MutationProof
 }
 
-// StreamRevisions repeatedly fetches revisions and sends them to out until
+// MapRevision is a map root that has been verified to be correctly signed and
+// included in the log.
+type MapRevision struct {
+	*types.MapRootV1
+	// Signed is the map root as signed by the key server. Map roots from the
+	// same server that are signed for the same revision but differ are proof
+	// that the server equivocated.
+	Signed *trillian.SignedMapRoot
+}
+
+// StreamRevisions verifies revisions from GetRevisionStream and sends them to
+// out until the stream returns an error or until ctx.Done is closed. If the
+// server does not implement GetRevisionStream, StreamRevisions falls back to
+// polling GetRevision.
+func (c *Client) StreamRevisions(ctx context.Context, startRevision int64, out chan<- *MapRevision) error {
+	defer close(out)
+	stream, err := c.cli.GetRevisionStream(ctx, &pb.GetRevisionRequest{
+		DirectoryId:  c.DirectoryID,
+		Revision:     startRevision,
+		LastVerified: c.LastVerifiedLogRoot(),
+	})
+	if err != nil {
+		return err
+	}
+	for i := startRevision; ; i++ {
+		resp, err := stream.Recv()
+		if status.Code(err) == codes.Unimplemented && i == startRevision {
+			glog.Infof("GetRevisionStream is unimplemented, polling GetRevision instead")
+			return c.pollRevisions(ctx, startRevision, out)
+		} else if err != nil {
+			glog.Warningf("GetRevisionStream(%v): %v", i, err)
+			return err
+		}
+
+		// Each log root in the stream is consistent with the one before it.
+		lr, err := c.VerifyLogRoot(c.LastVerifiedLogRoot(), resp.GetLatestLogRoot())
+		if err != nil {
+			return err
+		}
+		mr, err := c.VerifyMapRevision(lr, resp.GetMapRoot())
+		if err != nil {
+			return err
+		}
+		if got, want := int64(mr.Revision), i; got != want {
+			return fmt.Errorf("GetRevisionStream(): revision %v, want %v", got, want)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case out <- &MapRevision{MapRootV1: mr, Signed: resp.GetMapRoot().GetMapRoot()}:
+		}
+	}
+}
+
+// pollRevisions repeatedly fetches revisions and sends them to out until
 // GetRevision returns an error other than NotFound or until ctx.Done is
-// closed.  When GetRevision returns NotFound, it waits one pollPeriod before
+// closed.  When GetRevision returns NotFound, it waits one RetryDelay before
 // trying again.
-func (c *Client) StreamRevisions(ctx context.Context, startRevision int64, out chan<- *types.MapRootV1) error {
-	defer close(out)
+func (c *Client) pollRevisions(ctx context.Context, startRevision int64, out chan<- *MapRevision) error {
 	wait := time.NewTicker(c.RetryDelay)
 	defer wait.Stop()
 	for i := startRevision; ; {
-		mr, err := c.VerifiedGetRevision(ctx, i)
+		mr, err := c.verifiedGetRevision(ctx, i)
 
 		// If this revision was not found, wait and retry.
 		if s, _ := status.FromError(err); s.Code() == codes.NotFound {
@@ -70,8 +126,36 @@ func (c *Client) StreamRevisions(ctx context.Context, startRevision int64, out c
 	}
 }
 
-// RevisionMutations fetches all the mutations in an revision
+// RevisionMutations fetches all the mutations in an revision with
+// ListMutationsStream. If the server does not implement ListMutationsStream,
+// RevisionMutations pages through ListMutations instead.
 func (c *Client) RevisionMutations(ctx context.Context, mapRoot *types.MapRootV1) ([]*pb.MutationProof, error) {
+	stream, err := c.cli.ListMutationsStream(ctx, &pb.ListMutationsRequest{
+		DirectoryId: c.DirectoryID,
+		Revision:    int64(mapRoot.Revision),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list mutations stream on %v: %v", c.DirectoryID, err)
+	}
+	mutations := []*pb.MutationProof{}
+	for {
+		m, err := stream.Recv()
+		if err == io.EOF {
+			return mutations, nil
+		}
+		if status.Code(err) == codes.Unimplemented && len(mutations) == 0 {
+			glog.Infof("ListMutationsStream is unimplemented, paging through ListMutations instead")
+			return c.listRevisionMutations(ctx, mapRoot)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("list mutations stream on %v: %v", c.DirectoryID, err)
+		}
+		mutations = append(mutations, m)
+	}
+}
+
+// listRevisionMutations pages through all the mutations in an revision.
+func (c *Client) listRevisionMutations(ctx context.Context, mapRoot *types.MapRootV1) ([]*pb.MutationProof, error) {
 	mutations := []*pb.MutationProof{}
 	token := ""
 	for {
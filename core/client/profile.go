@@ -0,0 +1,117 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/tink/go/tink"
+	"google.golang.org/grpc"
+
+	"github.com/google/keytransparency/core/mutator/entry"
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+)
+
+// ProfileVersion is the version of the pb.Profile format written by this client.
+const ProfileVersion = 1
+
+// ErrNotProfile occurs when profile data is not a pb.Profile of a supported version.
+var ErrNotProfile = errors.New("client: profile data is not a device list")
+
+// ParseProfile parses profile data as a pb.Profile. Empty profile data is
+// parsed as a profile without devices.
+func ParseProfile(data []byte) (*pb.Profile, error) {
+	if len(data) == 0 {
+		return &pb.Profile{Version: ProfileVersion}, nil
+	}
+	var p pb.Profile
+	if err := proto.Unmarshal(data, &p); err != nil || p.GetVersion() != ProfileVersion {
+		return nil, ErrNotProfile
+	}
+	return &p, nil
+}
+
+// SetDevice adds d to p, replacing any device with the same label.
+func SetDevice(p *pb.Profile, d *pb.Device) {
+	for i, old := range p.Devices {
+		if old.GetLabel() == d.GetLabel() {
+			p.Devices[i] = d
+			return
+		}
+	}
+	p.Devices = append(p.Devices, d)
+}
+
+// RemoveDevice removes the device labeled label from p. It returns false if p
+// has no such device.
+func RemoveDevice(p *pb.Profile, label string) bool {
+	for i, d := range p.Devices {
+		if d.GetLabel() == label {
+			p.Devices = append(p.Devices[:i], p.Devices[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// AddDevice adds d to the device list of an existing user, replacing any
+// device with the same label and keeping the rest, and waits for the update to
+// appear.
+func (c *Client) AddDevice(ctx context.Context, userID string, d *pb.Device,
+	signers []tink.Signer, opts ...grpc.CallOption) (*entry.Mutation, error) {
+	return c.updateProfile(ctx, userID, func(p *pb.Profile) error {
+		SetDevice(p, d)
+		return nil
+	}, signers, opts...)
+}
+
+// RemoveDevice removes the device labeled label from the device list of a
+// user, keeping the rest, and waits for the update to appear.
+func (c *Client) RemoveDevice(ctx context.Context, userID, label string,
+	signers []tink.Signer, opts ...grpc.CallOption) (*entry.Mutation, error) {
+	return c.updateProfile(ctx, userID, func(p *pb.Profile) error {
+		if !RemoveDevice(p, label) {
+			return fmt.Errorf("client: %v has no device %q", userID, label)
+		}
+		return nil
+	}, signers, opts...)
+}
+
+// updateProfile applies edit to the current profile of userID and submits the
+// result. The update keeps the validity period of the current entry.
+func (c *Client) updateProfile(ctx context.Context, userID string, edit func(*pb.Profile) error,
+	signers []tink.Signer, opts ...grpc.CallOption) (*entry.Mutation, error) {
+	_, data, err := c.GetUser(ctx, userID, opts...)
+	if err != nil {
+		return nil, err
+	}
+	p, err := ParseProfile(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := edit(p); err != nil {
+		return nil, err
+	}
+	p.Version = ProfileVersion
+	profileData, err := proto.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	return c.Update(ctx, &User{UserID: userID, PublicKeyData: profileData}, signers, opts...)
+}
@@ -0,0 +1,83 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"testing"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+)
+
+func TestParseProfile(t *testing.T) {
+	profile, err := proto.Marshal(&pb.Profile{
+		Version: ProfileVersion,
+		Devices: []*pb.Device{{Label: "phone", PublicKey: []byte{1}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	future, err := proto.Marshal(&pb.Profile{Version: ProfileVersion + 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		desc    string
+		data    []byte
+		devices int
+		wantErr error
+	}{
+		{desc: "empty"},
+		{desc: "profile", data: profile, devices: 1},
+		{desc: "opaque", data: []byte("opaque key data"), wantErr: ErrNotProfile},
+		{desc: "unknown version", data: future, wantErr: ErrNotProfile},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			p, err := ParseProfile(tc.data)
+			if err != tc.wantErr {
+				t.Fatalf("ParseProfile(): %v, want %v", err, tc.wantErr)
+			}
+			if got := len(p.GetDevices()); got != tc.devices {
+				t.Errorf("ParseProfile(): %v devices, want %v", got, tc.devices)
+			}
+		})
+	}
+}
+
+func TestSetAndRemoveDevice(t *testing.T) {
+	p := &pb.Profile{Version: ProfileVersion}
+	SetDevice(p, &pb.Device{Label: "phone", PublicKey: []byte{1}})
+	SetDevice(p, &pb.Device{Label: "laptop", PublicKey: []byte{2}})
+	SetDevice(p, &pb.Device{Label: "phone", PublicKey: []byte{3}, Metadata: map[string]string{"os": "android"}})
+	want := &pb.Profile{Version: ProfileVersion, Devices: []*pb.Device{
+		{Label: "phone", PublicKey: []byte{3}, Metadata: map[string]string{"os": "android"}},
+		{Label: "laptop", PublicKey: []byte{2}},
+	}}
+	if !proto.Equal(p, want) {
+		t.Errorf("SetDevice(): %v, want %v", p, want)
+	}
+
+	if RemoveDevice(p, "tablet") {
+		t.Errorf("RemoveDevice(tablet): true, want false")
+	}
+	if !RemoveDevice(p, "phone") {
+		t.Errorf("RemoveDevice(phone): false, want true")
+	}
+	want = &pb.Profile{Version: ProfileVersion, Devices: []*pb.Device{{Label: "laptop", PublicKey: []byte{2}}}}
+	if !proto.Equal(p, want) {
+		t.Errorf("RemoveDevice(): %v, want %v", p, want)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/trillian/types"
+	"google.golang.org/grpc"
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	tpb "github.com/google/keytransparency/core/testdata/transcript_go_proto"
+)
+
+// Recorder records KeyTransparency RPCs and their responses into a transcript
+// that can later be verified offline.
+type Recorder struct {
+	mu         sync.Mutex
+	transcript *tpb.Transcript
+}
+
+// NewRecorder returns a Recorder with an empty transcript.
+func NewRecorder(description string) *Recorder {
+	return &Recorder{transcript: &tpb.Transcript{Description: description}}
+}
+
+// SetDirectory sets the directory config needed to verify the transcript.
+// GetDirectory responses set the directory config automatically.
+func (r *Recorder) SetDirectory(directory *pb.Directory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.transcript.Directory = proto.Clone(directory).(*pb.Directory)
+}
+
+// Transcript returns a copy of the RPCs recorded so far.
+func (r *Recorder) Transcript() *tpb.Transcript {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return proto.Clone(r.transcript).(*tpb.Transcript)
+}
+
+// UnaryClientInterceptor is a grpc.UnaryClientInterceptor that records each
+// successful KeyTransparency RPC. Requests and responses are copied before
+// they are returned to the caller, so they are recorded exactly as the server
+// sent them. RPCs that a transcript cannot represent are not recorded.
+func (r *Recorder) UnaryClientInterceptor(ctx context.Context, method string, req, reply interface{},
+	cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+		return err
+	}
+	r.record(method, req, reply)
+	return nil
+}
+
+// StreamClientInterceptor is a grpc.StreamClientInterceptor that records each
+// revision received from GetRevisionStream as the GetRevision action that
+// would have returned it. Other streams, such as ListMutationsStream, are not
+// recorded, just like their unary counterparts.
+func (r *Recorder) StreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn,
+	method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	cs, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &recordedStream{ClientStream: cs, r: r, method: method}, nil
+}
+
+// recordedStream records the revisions received from a GetRevisionStream.
+type recordedStream struct {
+	grpc.ClientStream
+	r      *Recorder
+	method string
+	// next is the GetRevision request for the next revision in the stream.
+	next *pb.GetRevisionRequest
+}
+
+func (s *recordedStream) SendMsg(m interface{}) error {
+	if err := s.ClientStream.SendMsg(m); err != nil {
+		return err
+	}
+	if req, ok := m.(*pb.GetRevisionRequest); ok {
+		s.next = proto.Clone(req).(*pb.GetRevisionRequest)
+	}
+	return nil
+}
+
+func (s *recordedStream) RecvMsg(m interface{}) error {
+	if err := s.ClientStream.RecvMsg(m); err != nil {
+		return err
+	}
+	rev, ok := m.(*pb.Revision)
+	if !ok || s.next == nil {
+		return nil
+	}
+	s.r.record(s.method, s.next, rev)
+
+	// The server proves that the log root of each later revision is
+	// consistent with the log root of this one.
+	var root types.LogRootV1
+	if err := root.UnmarshalBinary(rev.GetLatestLogRoot().GetLogRoot().GetLogRoot()); err != nil {
+		glog.Warningf("recorder: not recording the rest of %v: %v", s.method, err)
+		s.next = nil
+		return nil
+	}
+	s.next = &pb.GetRevisionRequest{
+		DirectoryId:  s.next.GetDirectoryId(),
+		Revision:     s.next.GetRevision() + 1,
+		LastVerified: &pb.LogRootRequest{TreeSize: int64(root.TreeSize), RootHash: root.RootHash},
+	}
+	return nil
+}
+
+func (r *Recorder) record(method string, req, reply interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	action := &tpb.Action{Desc: method}
+	switch req := req.(type) {
+	case *pb.GetDirectoryRequest:
+		r.transcript.Directory = proto.Clone(reply.(*pb.Directory)).(*pb.Directory)
+		return
+	case *pb.GetUserRequest:
+		action.ReqRespPair = &tpb.Action_GetUser{GetUser: &tpb.GetUser{
+			Request:  proto.Clone(req).(*pb.GetUserRequest),
+			Response: proto.Clone(reply.(*pb.GetUserResponse)).(*pb.GetUserResponse),
+		}}
+	case *pb.BatchGetUserRequest:
+		action.ReqRespPair = &tpb.Action_BatchGetUser{BatchGetUser: &tpb.BatchGetUser{
+			Request:  proto.Clone(req).(*pb.BatchGetUserRequest),
+			Response: proto.Clone(reply.(*pb.BatchGetUserResponse)).(*pb.BatchGetUserResponse),
+		}}
+	case *pb.BatchListUserRevisionsRequest:
+		action.ReqRespPair = &tpb.Action_BatchListUserRevisions{BatchListUserRevisions: &tpb.BatchListUserRevisions{
+			Request:  proto.Clone(req).(*pb.BatchListUserRevisionsRequest),
+			Response: proto.Clone(reply.(*pb.BatchListUserRevisionsResponse)).(*pb.BatchListUserRevisionsResponse),
+		}}
+	case *pb.GetRevisionRequest:
+		action.ReqRespPair = &tpb.Action_GetRevision{GetRevision: &tpb.GetRevision{
+			Request:  proto.Clone(req).(*pb.GetRevisionRequest),
+			Response: proto.Clone(reply.(*pb.Revision)).(*pb.Revision),
+		}}
+	case *pb.GetLatestRevisionRequest:
+		action.ReqRespPair = &tpb.Action_GetLatestRevision{GetLatestRevision: &tpb.GetLatestRevision{
+			Request:  proto.Clone(req).(*pb.GetLatestRevisionRequest),
+			Response: proto.Clone(reply.(*pb.Revision)).(*pb.Revision),
+		}}
+	case *pb.ListUserRevisionsRequest:
+		action.ReqRespPair = &tpb.Action_ListUserRevisions{ListUserRevisions: &tpb.ListUserRevisions{
+			Request:  proto.Clone(req).(*pb.ListUserRevisionsRequest),
+			Response: proto.Clone(reply.(*pb.ListUserRevisionsResponse)).(*pb.ListUserRevisionsResponse),
+		}}
+	default:
+		glog.V(2).Infof("recorder: not recording %v", method)
+		return
+	}
+	r.transcript.Actions = append(r.transcript.Actions, action)
+}
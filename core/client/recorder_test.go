@@ -0,0 +1,174 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/trillian"
+	"github.com/google/trillian/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	tpb "github.com/google/keytransparency/core/testdata/transcript_go_proto"
+)
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	directory := &pb.Directory{DirectoryId: "directory"}
+	revision := &pb.Revision{DirectoryId: "directory", MapRoot: &pb.MapRoot{LogInclusion: [][]byte{{1}}}}
+	// invoker returns resp, or err if set.
+	invoker := func(resp proto.Message, err error) grpc.UnaryInvoker {
+		return func(_ context.Context, _ string, _, reply interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			if err != nil {
+				return err
+			}
+			proto.Merge(reply.(proto.Message), resp)
+			return nil
+		}
+	}
+
+	r := NewRecorder("test")
+	for _, rpc := range []struct {
+		method   string
+		req      proto.Message
+		reply    proto.Message
+		resp     proto.Message
+		err      error
+		wantCode codes.Code
+	}{
+		{method: "/GetDirectory", req: &pb.GetDirectoryRequest{}, reply: &pb.Directory{}, resp: directory},
+		{method: "/GetRevision", req: &pb.GetRevisionRequest{Revision: 1}, reply: &pb.Revision{}, resp: revision},
+		{method: "/GetRevision", req: &pb.GetRevisionRequest{Revision: 2}, reply: &pb.Revision{},
+			err: status.Error(codes.NotFound, "not found"), wantCode: codes.NotFound},
+		{method: "/ListMutations", req: &pb.ListMutationsRequest{}, reply: &pb.ListMutationsResponse{},
+			resp: &pb.ListMutationsResponse{NextPageToken: "next"}},
+	} {
+		err := r.UnaryClientInterceptor(ctx, rpc.method, rpc.req, rpc.reply, nil, invoker(rpc.resp, rpc.err))
+		if got := status.Code(err); got != rpc.wantCode {
+			t.Errorf("%v: %v, want %v", rpc.method, err, rpc.wantCode)
+		}
+		// Callers may modify responses while verifying them.
+		if rev, ok := rpc.reply.(*pb.Revision); ok && err == nil {
+			rev.GetMapRoot().LogInclusion[0][0] = 2
+		}
+	}
+
+	want := &tpb.Transcript{
+		Description: "test",
+		Directory:   directory,
+		Actions: []*tpb.Action{{
+			Desc: "/GetRevision",
+			ReqRespPair: &tpb.Action_GetRevision{GetRevision: &tpb.GetRevision{
+				Request:  &pb.GetRevisionRequest{Revision: 1},
+				Response: revision,
+			}},
+		}},
+	}
+	if got := r.Transcript(); !proto.Equal(got, want) {
+		t.Errorf("Transcript(): %v, want %v", got, want)
+	}
+}
+
+// fakeStream returns resps from RecvMsg, then io.EOF.
+type fakeStream struct {
+	grpc.ClientStream
+	resps []proto.Message
+}
+
+func (s *fakeStream) SendMsg(interface{}) error { return nil }
+func (s *fakeStream) CloseSend() error          { return nil }
+
+func (s *fakeStream) RecvMsg(m interface{}) error {
+	if len(s.resps) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), s.resps[0])
+	s.resps = s.resps[1:]
+	return nil
+}
+
+func TestRecorderStream(t *testing.T) {
+	ctx := context.Background()
+	rootBytes, err := (&types.LogRootV1{TreeSize: 5, RootHash: []byte("root")}).MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	logRoot := &pb.LogRoot{LogRoot: &trillian.SignedLogRoot{LogRoot: rootBytes}}
+	rev3 := &pb.Revision{DirectoryId: "directory", MapRoot: &pb.MapRoot{LogInclusion: [][]byte{{3}}}, LatestLogRoot: logRoot}
+	rev4 := &pb.Revision{DirectoryId: "directory", MapRoot: &pb.MapRoot{LogInclusion: [][]byte{{4}}}, LatestLogRoot: logRoot}
+	req := &pb.GetRevisionRequest{DirectoryId: "directory", Revision: 3, LastVerified: &pb.LogRootRequest{TreeSize: 2}}
+
+	r := NewRecorder("test")
+	for _, rpc := range []struct {
+		method string
+		req    proto.Message
+		reply  func() proto.Message
+		resps  []proto.Message
+	}{
+		{method: "/GetRevisionStream", req: req, reply: func() proto.Message { return &pb.Revision{} },
+			resps: []proto.Message{rev3, rev4}},
+		{method: "/ListMutationsStream", req: &pb.ListMutationsRequest{Revision: 3},
+			reply: func() proto.Message { return &pb.MutationProof{} }, resps: []proto.Message{&pb.MutationProof{}}},
+	} {
+		streamer := func(context.Context, *grpc.StreamDesc, *grpc.ClientConn, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+			return &fakeStream{resps: rpc.resps}, nil
+		}
+		cs, err := r.StreamClientInterceptor(ctx, &grpc.StreamDesc{ServerStreams: true}, nil, rpc.method, streamer)
+		if err != nil {
+			t.Fatalf("%v: %v", rpc.method, err)
+		}
+		if err := cs.SendMsg(rpc.req); err != nil {
+			t.Fatalf("%v: SendMsg(): %v", rpc.method, err)
+		}
+		for {
+			err := cs.RecvMsg(rpc.reply())
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				t.Fatalf("%v: RecvMsg(): %v", rpc.method, err)
+			}
+		}
+	}
+
+	want := &tpb.Transcript{
+		Description: "test",
+		Actions: []*tpb.Action{{
+			Desc: "/GetRevisionStream",
+			ReqRespPair: &tpb.Action_GetRevision{GetRevision: &tpb.GetRevision{
+				Request:  req,
+				Response: rev3,
+			}},
+		}, {
+			Desc: "/GetRevisionStream",
+			ReqRespPair: &tpb.Action_GetRevision{GetRevision: &tpb.GetRevision{
+				Request: &pb.GetRevisionRequest{
+					DirectoryId:  "directory",
+					Revision:     4,
+					LastVerified: &pb.LogRootRequest{TreeSize: 5, RootHash: []byte("root")},
+				},
+				Response: rev4,
+			}},
+		}},
+	}
+	if got := r.Transcript(); !proto.Equal(got, want) {
+		t.Errorf("Transcript(): %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.12.1
+// source: misbehavior.proto
+
+package misbehavior_go_proto
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	keytransparency_go_proto "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	trillian "github.com/google/trillian"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+// MisbehaviorReport is self-contained evidence that a keytransparency server
+// presented a log root that is not consistent with a log root it presented
+// earlier. Anyone holding the directory's public keys can verify the report.
+type MisbehaviorReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// directory contains the configuration of the directory, including the
+	// public key that signed both log roots.
+	Directory *keytransparency_go_proto.Directory `protobuf:"bytes,1,opt,name=directory,proto3" json:"directory,omitempty"`
+	// trusted_log_root is the log root the client verified earlier.
+	// The signature is empty if the client restored the trusted root from an
+	// unsigned copy.
+	TrustedLogRoot *trillian.SignedLogRoot `protobuf:"bytes,2,opt,name=trusted_log_root,json=trustedLogRoot,proto3" json:"trusted_log_root,omitempty"`
+	// log_root is the signed log root that is not consistent with
+	// trusted_log_root.
+	LogRoot *trillian.SignedLogRoot `protobuf:"bytes,3,opt,name=log_root,json=logRoot,proto3" json:"log_root,omitempty"`
+	// log_consistency is the consistency proof from trusted_log_root to
+	// log_root that failed to verify.
+	LogConsistency [][]byte `protobuf:"bytes,4,rep,name=log_consistency,json=logConsistency,proto3" json:"log_consistency,omitempty"`
+	// error describes why verification failed.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// report_time is the time at which the client detected the misbehavior.
+	ReportTime *timestamp.Timestamp `protobuf:"bytes,6,opt,name=report_time,json=reportTime,proto3" json:"report_time,omitempty"`
+}
+
+func (x *MisbehaviorReport) Reset() {
+	*x = MisbehaviorReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_misbehavior_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MisbehaviorReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MisbehaviorReport) ProtoMessage() {}
+
+func (x *MisbehaviorReport) ProtoReflect() protoreflect.Message {
+	mi := &file_misbehavior_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MisbehaviorReport.ProtoReflect.Descriptor instead.
+func (*MisbehaviorReport) Descriptor() ([]byte, []int) {
+	return file_misbehavior_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MisbehaviorReport) GetDirectory() *keytransparency_go_proto.Directory {
+	if x != nil {
+		return x.Directory
+	}
+	return nil
+}
+
+func (x *MisbehaviorReport) GetTrustedLogRoot() *trillian.SignedLogRoot {
+	if x != nil {
+		return x.TrustedLogRoot
+	}
+	return nil
+}
+
+func (x *MisbehaviorReport) GetLogRoot() *trillian.SignedLogRoot {
+	if x != nil {
+		return x.LogRoot
+	}
+	return nil
+}
+
+func (x *MisbehaviorReport) GetLogConsistency() [][]byte {
+	if x != nil {
+		return x.LogConsistency
+	}
+	return nil
+}
+
+func (x *MisbehaviorReport) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *MisbehaviorReport) GetReportTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.ReportTime
+	}
+	return nil
+}
+
+var File_misbehavior_proto protoreflect.FileDescriptor
+
+var file_misbehavior_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x6d, 0x69, 0x73, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x76, 0x31, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0e, 0x74, 0x72, 0x69, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0e, 0x76, 0x31, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xca, 0x02, 0x0a, 0x11, 0x4d, 0x69, 0x73, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x41, 0x0a, 0x10, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74, 0x72, 0x69, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x0e, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x32, 0x0a, 0x08,
+	0x6c, 0x6f, 0x67, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x72, 0x69, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x6f, 0x74, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x52, 0x6f, 0x6f, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x6c, 0x6f, 0x67, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x4c, 0x5a, 0x4a,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x65, 0x72, 0x2f, 0x6d, 0x69, 0x73, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
+	0x72, 0x5f, 0x67, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_misbehavior_proto_rawDescOnce sync.Once
+	file_misbehavior_proto_rawDescData = file_misbehavior_proto_rawDesc
+)
+
+func file_misbehavior_proto_rawDescGZIP() []byte {
+	file_misbehavior_proto_rawDescOnce.Do(func() {
+		file_misbehavior_proto_rawDescData = protoimpl.X.CompressGZIP(file_misbehavior_proto_rawDescData)
+	})
+	return file_misbehavior_proto_rawDescData
+}
+
+var file_misbehavior_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_misbehavior_proto_goTypes = []interface{}{
+	(*MisbehaviorReport)(nil),                  // 0: google.keytransparency.v1.MisbehaviorReport
+	(*keytransparency_go_proto.Directory)(nil), // 1: google.keytransparency.v1.Directory
+	(*trillian.SignedLogRoot)(nil),             // 2: trillian.SignedLogRoot
+	(*timestamp.Timestamp)(nil),                // 3: google.protobuf.Timestamp
+}
+var file_misbehavior_proto_depIdxs = []int32{
+	1, // 0: google.keytransparency.v1.MisbehaviorReport.directory:type_name -> google.keytransparency.v1.Directory
+	2, // 1: google.keytransparency.v1.MisbehaviorReport.trusted_log_root:type_name -> trillian.SignedLogRoot
+	2, // 2: google.keytransparency.v1.MisbehaviorReport.log_root:type_name -> trillian.SignedLogRoot
+	3, // 3: google.keytransparency.v1.MisbehaviorReport.report_time:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_misbehavior_proto_init() }
+func file_misbehavior_proto_init() {
+	if File_misbehavior_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_misbehavior_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MisbehaviorReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_misbehavior_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_misbehavior_proto_goTypes,
+		DependencyIndexes: file_misbehavior_proto_depIdxs,
+		MessageInfos:      file_misbehavior_proto_msgTypes,
+	}.Build()
+	File_misbehavior_proto = out.File
+	file_misbehavior_proto_rawDesc = nil
+	file_misbehavior_proto_goTypes = nil
+	file_misbehavior_proto_depIdxs = nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/golang/protobuf/jsonpb" //nolint:staticcheck
+
+	mpb "github.com/google/keytransparency/core/client/tracker/misbehavior_go_proto"
+)
+
+// Reporter receives evidence that a server has misbehaved.
+type Reporter interface {
+	ReportMisbehavior(report *mpb.MisbehaviorReport) error
+}
+
+// ReporterFunc adapts an ordinary function to the Reporter interface.
+type ReporterFunc func(report *mpb.MisbehaviorReport) error
+
+// ReportMisbehavior calls f(report).
+func (f ReporterFunc) ReportMisbehavior(report *mpb.MisbehaviorReport) error {
+	return f(report)
+}
+
+// FileReporter writes each report to a new JSON file in a directory.
+type FileReporter struct {
+	dir string
+}
+
+// NewFileReporter returns a Reporter that writes reports to dir.
+func NewFileReporter(dir string) *FileReporter {
+	return &FileReporter{dir: dir}
+}
+
+// ReportMisbehavior writes report to a new file in the report directory.
+func (r *FileReporter) ReportMisbehavior(report *mpb.MisbehaviorReport) error {
+	if err := os.MkdirAll(r.dir, 0700); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(r.dir, "misbehavior-*.json")
+	if err != nil {
+		return err
+	}
+	m := jsonpb.Marshaler{Indent: "\t"}
+	if err := m.Marshal(f, report); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
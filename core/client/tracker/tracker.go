@@ -20,11 +20,13 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/golang/protobuf/ptypes"
 	"github.com/google/trillian/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	mpb "github.com/google/keytransparency/core/client/tracker/misbehavior_go_proto"
 	tpb "github.com/google/trillian"
 )
 
@@ -41,8 +43,12 @@ type LogRootVerifier interface {
 // LogTracker tracks a series of consistent log roots.
 type LogTracker struct {
 	trusted       types.LogRootV1
+	trustedSigned *tpb.SignedLogRoot // The signed copy of trusted, if known.
 	v             LogRootVerifier
 	updateTrusted UpdateTrustedPredicate
+	store         TrustStore
+	directory     *pb.Directory
+	reporter      Reporter
 	mu            sync.RWMutex
 }
 
@@ -56,6 +62,27 @@ func NewFromSaved(lv LogRootVerifier, lr types.LogRootV1) *LogTracker {
 	return &LogTracker{v: lv, trusted: lr, updateTrusted: isNewer}
 }
 
+// NewFromTrustStore creates a log tracker from the root saved in store and
+// saves each new trusted root to store.
+func NewFromTrustStore(lv LogRootVerifier, store TrustStore) (*LogTracker, error) {
+	l := &LogTracker{v: lv, updateTrusted: isNewer, store: store}
+	slr, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+	if slr == nil {
+		return l, nil
+	}
+	// Check the signature of the saved root, but not its consistency.
+	lr, err := lv.VerifyRoot(&types.LogRootV1{}, slr, nil)
+	if err != nil {
+		return nil, status.Errorf(codes.DataLoss, "saved trusted root: %v", err)
+	}
+	l.trusted = *lr
+	l.trustedSigned = slr
+	return l, nil
+}
+
 // LastVerifiedLogRoot retrieves the tree size of the latest log root.
 func (l *LogTracker) LastVerifiedLogRoot() *pb.LogRootRequest {
 	l.mu.RLock()
@@ -85,10 +112,17 @@ func (l *LogTracker) VerifyLogRoot(state *pb.LogRootRequest, root *pb.LogRoot) (
 		root.GetLogRoot(),
 		root.GetLogConsistency())
 	if err != nil {
+		l.reportMisbehavior(root, err)
 		return nil, err
 	}
 	if l.updateTrusted(l.trusted, *logRoot) {
+		if l.store != nil {
+			if err := l.store.Save(root.GetLogRoot()); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to save trusted root: %v", err)
+			}
+		}
 		l.trusted = *logRoot
+		l.trustedSigned = root.GetLogRoot()
 		glog.Infof("Trusted root updated to TreeSize %v", l.trusted.TreeSize)
 	}
 	return logRoot, nil
@@ -100,6 +134,49 @@ func (l *LogTracker) SetUpdatePredicate(f UpdateTrustedPredicate) {
 	l.updateTrusted = f
 }
 
+// SetReporter sends a MisbehaviorReport to r whenever the server presents a
+// correctly signed log root that is not consistent with the trusted root.
+// directory is included in each report so that the report can be verified
+// on its own.
+func (l *LogTracker) SetReporter(directory *pb.Directory, r Reporter) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.directory = directory
+	l.reporter = r
+}
+
+// reportMisbehavior reports root if it is correctly signed but failed to
+// verify against the trusted root. Roots with bad signatures are not evidence
+// of anything and are not reported.
+func (l *LogTracker) reportMisbehavior(root *pb.LogRoot, verifyErr error) {
+	if l.reporter == nil || l.trusted.TreeSize == 0 {
+		return
+	}
+	if _, err := l.v.VerifyRoot(&types.LogRootV1{}, root.GetLogRoot(), nil); err != nil {
+		return
+	}
+	trusted := l.trustedSigned
+	if trusted == nil {
+		b, err := l.trusted.MarshalBinary()
+		if err != nil {
+			glog.Errorf("logtracker: failed to marshal trusted root: %v", err)
+			return
+		}
+		trusted = &tpb.SignedLogRoot{LogRoot: b}
+	}
+	report := &mpb.MisbehaviorReport{
+		Directory:      l.directory,
+		TrustedLogRoot: trusted,
+		LogRoot:        root.GetLogRoot(),
+		LogConsistency: root.GetLogConsistency(),
+		Error:          verifyErr.Error(),
+		ReportTime:     ptypes.TimestampNow(),
+	}
+	if err := l.reporter.ReportMisbehavior(report); err != nil {
+		glog.Errorf("logtracker: failed to report misbehavior: %v", err)
+	}
+}
+
 // isNewer returns true when newRoot is newer than cntRoot.
 func isNewer(cntRoot, newRoot types.LogRootV1) bool {
 	if newRoot.TreeSize > cntRoot.TreeSize {
@@ -15,14 +15,17 @@
 package tracker
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/trillian/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	mpb "github.com/google/keytransparency/core/client/tracker/misbehavior_go_proto"
 	tpb "github.com/google/trillian"
 )
 
@@ -69,6 +72,79 @@ func TestVerifyLogRoot(t *testing.T) {
 	}
 }
 
+func TestReportMisbehavior(t *testing.T) {
+	directory := &pb.Directory{DirectoryId: "directory"}
+	trusted := mustSignLogRoot(t, types.LogRootV1{TreeSize: 2, RootHash: []byte("root2")})
+	forked := mustSignLogRoot(t, types.LogRootV1{TreeSize: 3, RootHash: []byte("fork")})
+	forked.LogConsistency = [][]byte{[]byte("proof")}
+
+	for _, tc := range []struct {
+		desc       string
+		lv         LogRootVerifier
+		wantErr    bool
+		wantReport bool
+	}{
+		{desc: "consistent", lv: &fakeLogVerifier{}},
+		{desc: "inconsistent", lv: &inconsistentLogVerifier{}, wantErr: true, wantReport: true},
+		{desc: "bad signature", lv: &inconsistentLogVerifier{badSig: true}, wantErr: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			store := NewMemoryTrustStore()
+			if err := store.Save(trusted.GetLogRoot()); err != nil {
+				t.Fatalf("Save(): %v", err)
+			}
+			lt, err := NewFromTrustStore(&fakeLogVerifier{}, store)
+			if err != nil {
+				t.Fatalf("NewFromTrustStore(): %v", err)
+			}
+			lt.v = tc.lv
+			var reports []*mpb.MisbehaviorReport
+			lt.SetReporter(directory, ReporterFunc(func(r *mpb.MisbehaviorReport) error {
+				reports = append(reports, r)
+				return nil
+			}))
+
+			_, err = lt.VerifyLogRoot(lt.LastVerifiedLogRoot(), forked)
+			if got := err != nil; got != tc.wantErr {
+				t.Fatalf("VerifyLogRoot(): %v, want err %v", err, tc.wantErr)
+			}
+			if got := len(reports) == 1; got != tc.wantReport {
+				t.Fatalf("got %v reports, want report: %v", len(reports), tc.wantReport)
+			}
+			if !tc.wantReport {
+				return
+			}
+			want := &mpb.MisbehaviorReport{
+				Directory:      directory,
+				TrustedLogRoot: trusted.GetLogRoot(),
+				LogRoot:        forked.GetLogRoot(),
+				LogConsistency: forked.GetLogConsistency(),
+				Error:          err.Error(),
+				ReportTime:     reports[0].GetReportTime(),
+			}
+			if got := reports[0]; !proto.Equal(got, want) {
+				t.Errorf("ReportMisbehavior(%v), want %v", got, want)
+			}
+		})
+	}
+}
+
+// inconsistentLogVerifier fails every consistency proof from a non-empty
+// trusted root. If badSig is set, it also fails every signature.
+type inconsistentLogVerifier struct {
+	badSig bool
+}
+
+func (v *inconsistentLogVerifier) VerifyRoot(trusted *types.LogRootV1, r *tpb.SignedLogRoot, consistency [][]byte) (*types.LogRootV1, error) {
+	if v.badSig {
+		return nil, errors.New("bad signature")
+	}
+	if trusted.TreeSize != 0 {
+		return nil, errors.New("inconsistent")
+	}
+	return (&fakeLogVerifier{}).VerifyRoot(trusted, r, consistency)
+}
+
 func mustSignLogRoot(t *testing.T, lr types.LogRootV1) *pb.LogRoot {
 	t.Helper()
 	logRoot, err := lr.MarshalBinary()
@@ -0,0 +1,110 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+
+	tpb "github.com/google/trillian"
+)
+
+// TrustStore persists the trusted log root between runs of a client.
+type TrustStore interface {
+	// Load returns the saved log root, or nil if none has been saved.
+	Load() (*tpb.SignedLogRoot, error)
+	// Save replaces the saved log root with root.
+	Save(root *tpb.SignedLogRoot) error
+}
+
+// MemoryTrustStore keeps the trusted log root in memory.
+type MemoryTrustStore struct {
+	mu   sync.Mutex
+	root *tpb.SignedLogRoot
+}
+
+// NewMemoryTrustStore returns a TrustStore that does not outlive the process.
+func NewMemoryTrustStore() *MemoryTrustStore {
+	return &MemoryTrustStore{}
+}
+
+// Load returns the saved log root.
+func (s *MemoryTrustStore) Load() (*tpb.SignedLogRoot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.root, nil
+}
+
+// Save replaces the saved log root with root.
+func (s *MemoryTrustStore) Save(root *tpb.SignedLogRoot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.root = root
+	return nil
+}
+
+// FileTrustStore keeps the trusted log root in a file.
+type FileTrustStore struct {
+	path string
+}
+
+// NewFileTrustStore returns a TrustStore that saves the log root to path.
+func NewFileTrustStore(path string) *FileTrustStore {
+	return &FileTrustStore{path: path}
+}
+
+// Load reads the saved log root. Returns nil if the file does not exist.
+func (s *FileTrustStore) Load() (*tpb.SignedLogRoot, error) {
+	b, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var root tpb.SignedLogRoot
+	if err := proto.Unmarshal(b, &root); err != nil {
+		return nil, err
+	}
+	return &root, nil
+}
+
+// Save atomically replaces the contents of the file with root.
+func (s *FileTrustStore) Save(root *tpb.SignedLogRoot) error {
+	b, err := proto.Marshal(root)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(s.path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(dir, filepath.Base(s.path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name()) // Fails harmlessly after a successful rename.
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), s.path)
+}
@@ -0,0 +1,100 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracker
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/trillian/types"
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	tpb "github.com/google/trillian"
+)
+
+func TestTrustStores(t *testing.T) {
+	dir, err := ioutil.TempDir("", "truststore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, tc := range []struct {
+		desc     string
+		newStore func() TrustStore
+	}{
+		{desc: "memory", newStore: func() TrustStore { return NewMemoryTrustStore() }},
+		{desc: "file", newStore: func() TrustStore {
+			return NewFileTrustStore(filepath.Join(dir, "subdir", "logroot"))
+		}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			s := tc.newStore()
+			got, err := s.Load()
+			if err != nil {
+				t.Fatalf("Load(): %v", err)
+			}
+			if got != nil {
+				t.Errorf("Load(): %v, want nil", got)
+			}
+			for _, root := range []*tpb.SignedLogRoot{
+				{LogRoot: []byte("root1"), LogRootSignature: []byte("sig1")},
+				{LogRoot: []byte("root5"), LogRootSignature: []byte("sig5")},
+			} {
+				if err := s.Save(root); err != nil {
+					t.Fatalf("Save(): %v", err)
+				}
+				got, err := s.Load()
+				if err != nil {
+					t.Fatalf("Load(): %v", err)
+				}
+				if !proto.Equal(got, root) {
+					t.Errorf("Load(): %v, want %v", got, root)
+				}
+			}
+		})
+	}
+}
+
+func TestFileTrustStorePersists(t *testing.T) {
+	dir, err := ioutil.TempDir("", "truststore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "logroot")
+
+	lt, err := NewFromTrustStore(&fakeLogVerifier{}, NewFileTrustStore(path))
+	if err != nil {
+		t.Fatalf("NewFromTrustStore(): %v", err)
+	}
+	if _, err := lt.VerifyLogRoot(lt.LastVerifiedLogRoot(),
+		mustSignLogRoot(t, types.LogRootV1{TreeSize: 3, RootHash: []byte("root3")})); err != nil {
+		t.Fatalf("VerifyLogRoot(): %v", err)
+	}
+
+	// A new tracker, as in a later run of the client, starts from the saved root.
+	lt2, err := NewFromTrustStore(&fakeLogVerifier{}, NewFileTrustStore(path))
+	if err != nil {
+		t.Fatalf("NewFromTrustStore(): %v", err)
+	}
+	want := &pb.LogRootRequest{TreeSize: 3, RootHash: []byte("root3")}
+	if got := lt2.LastVerifiedLogRoot(); !proto.Equal(got, want) {
+		t.Errorf("LastVerifiedLogRoot(): %v, want %v", got, want)
+	}
+}
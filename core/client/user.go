@@ -14,14 +14,36 @@
 
 package client
 
-import "github.com/google/tink/go/keyset"
+import (
+	"time"
+
+	"github.com/google/tink/go/keyset"
+)
 
 // User represents plain account information that gets committed to and  obfuscated in Entry.
 type User struct {
 	// UserID specifies the user.
 	UserID string
-	// PublicKeyData is the public key material for this account.
+	// PublicKeyData is the public key material for this account. Accounts
+	// with several devices store a serialized pb.Profile here, see ParseProfile.
 	PublicKeyData []byte
 	// AuthorizedKeys is the tink keyset that validates the signatures on the next entry.
 	AuthorizedKeys *keyset.Handle
+	// SignatureThreshold is the number of distinct AuthorizedKeys that must
+	// sign the next entry. It is ignored unless AuthorizedKeys is set.
+	SignatureThreshold int32
+	// RecoveryKeys, if set, is the tink keyset that can replace this account
+	// if the AuthorizedKeys are lost.
+	RecoveryKeys *keyset.Handle
+	// RecoveryDelay is the number of revisions that a recovery must remain
+	// pending before it can be completed.
+	RecoveryDelay int64
+	// NotBefore and NotAfter, if either is set, replace the period during
+	// which clients accept the account's entry. If neither is set, an update
+	// keeps the period of the current entry. Entries are not served after
+	// NotAfter unless they are updated with a later NotAfter.
+	NotBefore, NotAfter time.Time
+	// ClearValidityPeriod removes the validity period of the account's entry.
+	// It is ignored if NotBefore or NotAfter is set.
+	ClearValidityPeriod bool
 }
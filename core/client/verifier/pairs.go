@@ -28,6 +28,9 @@ func (v *Verifier) VerifyGetUser(req *pb.GetUserRequest, resp *pb.GetUserRespons
 	if err != nil {
 		return err
 	}
+	if err := v.VerifyFreshness(mr); err != nil {
+		return err
+	}
 	return v.VerifyMapLeaf(req.DirectoryId, req.UserId, resp.Leaf, mr)
 }
 
@@ -41,6 +44,9 @@ func (v *Verifier) VerifyBatchGetUser(req *pb.BatchGetUserRequest, resp *pb.Batc
 	if err != nil {
 		return err
 	}
+	if err := v.VerifyFreshness(mr); err != nil {
+		return err
+	}
 	for userID, leaf := range resp.MapLeavesByUserId {
 		if err := v.VerifyMapLeaf(req.DirectoryId, userID, leaf, mr); err != nil {
 			return err
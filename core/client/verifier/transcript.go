@@ -0,0 +1,224 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verifier
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/trillian/types"
+
+	"github.com/google/keytransparency/core/client/tracker"
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	tpb "github.com/google/keytransparency/core/testdata/transcript_go_proto"
+	tclient "github.com/google/trillian/client"
+)
+
+var (
+	// ErrLogFork occurs when a transcript contains two different log roots
+	// of the same size.
+	ErrLogFork = errors.New("log roots of the same size differ")
+	// ErrUnverifiedLastVerified occurs when a request claims to have last
+	// verified a log root that no earlier action in the transcript verified.
+	ErrUnverifiedLastVerified = errors.New("last verified log root was not verified by an earlier action")
+)
+
+// TranscriptError identifies the action in a transcript that failed to verify
+// and the proof within that action that failed.
+type TranscriptError struct {
+	Action int    // Action is the index of the action in the transcript.
+	Desc   string // Desc is the description of the action.
+	RPC    string // RPC is the name of the recorded RPC.
+	Proof  string // Proof describes the proof that failed.
+	Err    error
+}
+
+func (e *TranscriptError) Error() string {
+	return fmt.Sprintf("action %d (%q): %v: %v: %v", e.Action, e.Desc, e.RPC, e.Proof, e.Err)
+}
+
+// Unwrap returns the verification error.
+func (e *TranscriptError) Unwrap() error { return e.Err }
+
+// transcriptVerifier verifies the actions of a transcript in order.
+type transcriptVerifier struct {
+	*Verifier
+	// verified holds the hash of every log root verified so far, by tree size.
+	verified map[uint64][]byte
+}
+
+// VerifyTranscript verifies every request and response pair recorded in t
+// against the public keys in t.Directory. Each action is verified from the
+// log root its request claims to have last verified, which must be a root
+// that an earlier action verified. All log roots in t must belong to a single
+// log. Returns a *TranscriptError describing the first proof that fails.
+func VerifyTranscript(t *tpb.Transcript) error {
+	verifier, err := NewFromDirectory(t.GetDirectory(),
+		func(lv *tclient.LogVerifier) LogTracker { return tracker.NewSynchronous(lv) },
+	)
+	if err != nil {
+		return fmt.Errorf("transcript directory: %v", err)
+	}
+	v := &transcriptVerifier{Verifier: verifier, verified: make(map[uint64][]byte)}
+	for i, action := range t.GetActions() {
+		if err := v.verifyAction(action); err != nil {
+			err.Action = i
+			err.Desc = action.GetDesc()
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyAction verifies a single request and response pair.
+func (v *transcriptVerifier) verifyAction(action *tpb.Action) *TranscriptError {
+	switch pair := action.ReqRespPair.(type) {
+	case *tpb.Action_GetUser:
+		req, resp := pair.GetUser.GetRequest(), pair.GetUser.GetResponse()
+		rpc := "GetUser"
+		mr, err := v.verifyRevision(rpc, req.GetLastVerified(), resp.GetRevision().GetLatestLogRoot(), resp.GetRevision().GetMapRoot())
+		if err != nil {
+			return err
+		}
+		return v.verifyLeaves(rpc, req.GetDirectoryId(), map[string]*pb.MapLeaf{req.GetUserId(): resp.GetLeaf()}, mr)
+
+	case *tpb.Action_BatchGetUser:
+		req, resp := pair.BatchGetUser.GetRequest(), pair.BatchGetUser.GetResponse()
+		rpc := "BatchGetUser"
+		mr, err := v.verifyRevision(rpc, req.GetLastVerified(), resp.GetRevision().GetLatestLogRoot(), resp.GetRevision().GetMapRoot())
+		if err != nil {
+			return err
+		}
+		return v.verifyLeaves(rpc, req.GetDirectoryId(), resp.GetMapLeavesByUserId(), mr)
+
+	case *tpb.Action_BatchListUserRevisions:
+		req, resp := pair.BatchListUserRevisions.GetRequest(), pair.BatchListUserRevisions.GetResponse()
+		rpc := "BatchListUserRevisions"
+		lr, terr := v.verifyLogRoot(rpc, req.GetLastVerified(), resp.GetLatestLogRoot())
+		if terr != nil {
+			return terr
+		}
+		for _, rev := range resp.GetMapRevisions() {
+			mr, err := v.VerifyMapRevision(lr, rev.GetMapRoot())
+			if err != nil {
+				return &TranscriptError{RPC: rpc, Proof: "map root", Err: err}
+			}
+			if err := v.verifyLeaves(rpc, req.GetDirectoryId(), rev.GetMapLeavesByUserId(), mr); err != nil {
+				err.Proof = fmt.Sprintf("revision %v: %v", mr.Revision, err.Proof)
+				return err
+			}
+		}
+		return nil
+
+	case *tpb.Action_GetRevision:
+		req, resp := pair.GetRevision.GetRequest(), pair.GetRevision.GetResponse()
+		_, err := v.verifyRevision("GetRevision", req.GetLastVerified(), resp.GetLatestLogRoot(), resp.GetMapRoot())
+		return err
+
+	case *tpb.Action_GetLatestRevision:
+		req, resp := pair.GetLatestRevision.GetRequest(), pair.GetLatestRevision.GetResponse()
+		_, err := v.verifyRevision("GetLatestRevision", req.GetLastVerified(), resp.GetLatestLogRoot(), resp.GetMapRoot())
+		return err
+
+	case *tpb.Action_ListUserRevisions:
+		req, resp := pair.ListUserRevisions.GetRequest(), pair.ListUserRevisions.GetResponse()
+		rpc := "ListUserRevisions"
+		lr, terr := v.verifyLogRoot(rpc, req.GetLastVerified(), resp.GetLatestLogRoot())
+		if terr != nil {
+			return terr
+		}
+		for _, rev := range resp.GetMapRevisions() {
+			mr, err := v.VerifyMapRevision(lr, rev.GetMapRoot())
+			if err != nil {
+				return &TranscriptError{RPC: rpc, Proof: "map root", Err: err}
+			}
+			leaves := map[string]*pb.MapLeaf{req.GetUserId(): rev.GetMapLeaf()}
+			if err := v.verifyLeaves(rpc, req.GetDirectoryId(), leaves, mr); err != nil {
+				err.Proof = fmt.Sprintf("revision %v: %v", mr.Revision, err.Proof)
+				return err
+			}
+		}
+		return nil
+
+	default:
+		return &TranscriptError{RPC: "unknown", Proof: "request type", Err: fmt.Errorf("unknown ReqRespPair: %T", pair)}
+	}
+}
+
+// verifyLogRoot verifies logRoot from the log root the recorded request
+// claims to have last verified. lastVerified must be empty, or be a log root
+// that was verified by an earlier action. The first action in a transcript
+// may start from any log root. Log roots of the same size must be identical.
+func (v *transcriptVerifier) verifyLogRoot(rpc string, lastVerified *pb.LogRootRequest,
+	logRoot *pb.LogRoot) (*types.LogRootV1, *TranscriptError) {
+	trusted := types.LogRootV1{
+		TreeSize: uint64(lastVerified.GetTreeSize()),
+		RootHash: lastVerified.GetRootHash(),
+	}
+	if trusted.TreeSize > 0 {
+		hash, ok := v.verified[trusted.TreeSize]
+		switch {
+		case len(v.verified) == 0:
+			v.verified[trusted.TreeSize] = trusted.RootHash
+		case !ok || !bytes.Equal(hash, trusted.RootHash):
+			return nil, &TranscriptError{RPC: rpc, Proof: "last verified log root", Err: ErrUnverifiedLastVerified}
+		}
+	}
+	v.lt = tracker.NewFromSaved(v.lv, trusted)
+	lr, err := v.VerifyLogRoot(v.LastVerifiedLogRoot(), logRoot)
+	if err != nil {
+		return nil, &TranscriptError{RPC: rpc, Proof: "log root", Err: err}
+	}
+	if hash, ok := v.verified[lr.TreeSize]; ok && !bytes.Equal(hash, lr.RootHash) {
+		return nil, &TranscriptError{RPC: rpc, Proof: "log root",
+			Err: fmt.Errorf("%w: size %v: %x, previously verified %x", ErrLogFork, lr.TreeSize, lr.RootHash, hash)}
+	}
+	v.verified[lr.TreeSize] = lr.RootHash
+	return lr, nil
+}
+
+// verifyRevision verifies the log root and the map root of a recorded response.
+func (v *transcriptVerifier) verifyRevision(rpc string, lastVerified *pb.LogRootRequest,
+	logRoot *pb.LogRoot, mapRoot *pb.MapRoot) (*types.MapRootV1, *TranscriptError) {
+	lr, terr := v.verifyLogRoot(rpc, lastVerified, logRoot)
+	if terr != nil {
+		return nil, terr
+	}
+	mr, err := v.VerifyMapRevision(lr, mapRoot)
+	if err != nil {
+		return nil, &TranscriptError{RPC: rpc, Proof: "map root", Err: err}
+	}
+	return mr, nil
+}
+
+// verifyLeaves verifies leaves in user ID order so that failures are reported
+// deterministically.
+func (v *transcriptVerifier) verifyLeaves(rpc, directoryID string, leaves map[string]*pb.MapLeaf,
+	mr *types.MapRootV1) *TranscriptError {
+	userIDs := make([]string, 0, len(leaves))
+	for userID := range leaves {
+		userIDs = append(userIDs, userID)
+	}
+	sort.Strings(userIDs)
+	for _, userID := range userIDs {
+		if err := v.VerifyMapLeaf(directoryID, userID, leaves[userID], mr); err != nil {
+			return &TranscriptError{RPC: rpc, Proof: fmt.Sprintf("map leaf for %q", userID), Err: err}
+		}
+	}
+	return nil
+}
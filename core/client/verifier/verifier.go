@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
@@ -41,6 +42,9 @@ import (
 var (
 	// ErrNilProof occurs when the provided GetUserResponse contains a nil proof.
 	ErrNilProof = errors.New("nil proof")
+	// ErrStaleMapRoot occurs when a map root is older than the freshness
+	// requirement set with RequireFreshness.
+	ErrStaleMapRoot = errors.New("stale map root")
 )
 
 // LogTracker tracks a series of consistent log roots.
@@ -62,6 +66,7 @@ type Verifier struct {
 	lv      *tclient.LogVerifier
 	lt      LogTracker
 	verbose *log.Logger
+	maxAge  time.Duration // Zero disables freshness checks.
 }
 
 // New creates a new instance of the client verifier.
@@ -101,6 +106,31 @@ func NewFromDirectory(config *pb.Directory, f LogTrackerFactory) (*Verifier, err
 	return New(vrfPubKey, mapVerifier, logVerifier, tracker), nil
 }
 
+// RequireFreshness makes VerifyFreshness reject map roots that are older than
+// maxInterval plus skew. maxInterval is normally the directory's MaxInterval,
+// the longest the server may go without publishing a new revision. skew allows
+// for clock differences and publishing delays.
+func (v *Verifier) RequireFreshness(maxInterval, skew time.Duration) {
+	v.maxAge = maxInterval + skew
+}
+
+// VerifyFreshness returns ErrStaleMapRoot if freshness checks are enabled and
+// mapRoot is older than allowed. It should only be applied to map roots that
+// are expected to be the latest, not to historical revisions.
+func (v *Verifier) VerifyFreshness(mapRoot *types.MapRootV1) error {
+	if v.maxAge <= 0 {
+		return nil
+	}
+	age := time.Since(time.Unix(0, int64(mapRoot.TimestampNanos)))
+	if age > v.maxAge {
+		v.verbose.Printf("✗ Map root freshness verification failed.")
+		return fmt.Errorf("%w: revision %v is %v old, want at most %v",
+			ErrStaleMapRoot, mapRoot.Revision, age, v.maxAge)
+	}
+	v.verbose.Printf("✓ Map root freshness verified.")
+	return nil
+}
+
 // Index computes the index from a VRF proof.
 func (v *Verifier) Index(vrfProof []byte, directoryID, userID string) ([]byte, error) {
 	index, err := v.vrf.ProofToHash([]byte(userID), vrfProof)
@@ -114,6 +144,9 @@ func (v *Verifier) Index(vrfProof []byte, directoryID, userID string) ([]byte, e
 //  - Verify commitment.
 //  - Verify VRF and index.
 //  - Verify map inclusion proof.
+// If the entry is outside of its validity period at the time of mapRoot,
+// VerifyMapLeaf clears the committed data in the leaf so that callers treat
+// the entry as absent.
 func (v *Verifier) VerifyMapLeaf(directoryID, userID string,
 	in *pb.MapLeaf, mapRoot *types.MapRootV1) error {
 	if mapRoot == nil {
@@ -134,7 +167,11 @@ func (v *Verifier) VerifyMapLeaf(directoryID, userID string,
 
 	// If this is not a proof of absence, verify the connection between
 	// profileData and the commitment in the merkle tree leaf.
-	if in.GetCommitted() != nil {
+	// Tombstones have no profileData.
+	if e.GetDeleted() && in.GetCommitted() != nil {
+		v.verbose.Printf("✗ Tombstone verification failed.")
+		return fmt.Errorf("deleted entry has committed data")
+	} else if in.GetCommitted() != nil {
 		commitment := e.GetCommitment()
 		data := in.GetCommitted().GetData()
 		nonce := in.GetCommitted().GetKey()
@@ -168,6 +205,11 @@ func (v *Verifier) VerifyMapLeaf(directoryID, userID string,
 		return fmt.Errorf("map inclusion proof failed: %v", err)
 	}
 	v.verbose.Printf("✓ map inclusion proof verified.")
+
+	if leafValue != nil && !entry.InValidityPeriod(&e, time.Unix(0, int64(mapRoot.TimestampNanos))) {
+		v.verbose.Printf("✗ Entry is outside of its validity period. Treating it as absent.")
+		in.Committed = nil
+	}
 	return nil
 }
 
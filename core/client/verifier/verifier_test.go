@@ -15,7 +15,9 @@
 package verifier
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/keytransparency/core/client/tracker"
 	"github.com/google/keytransparency/core/testdata"
@@ -41,6 +43,90 @@ func TestTranscripts(t *testing.T) {
 	}
 }
 
+func TestVerifyTranscript(t *testing.T) {
+	for _, name := range []string{
+		"TestEmptyGetAndUpdate",
+		"TestBatchGetUser",
+		"TestBatchListUserRevisions",
+	} {
+		t.Run(name, func(t *testing.T) {
+			transcript, err := testdata.ReadTranscript(name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := VerifyTranscript(transcript); err != nil {
+				t.Errorf("VerifyTranscript(): %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyTranscriptReportsFailure(t *testing.T) {
+	transcript, err := testdata.ReadTranscript("TestEmptyGetAndUpdate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const i = 1
+	action := transcript.Actions[i]
+	mapRoot := action.GetGetUser().GetResponse().GetRevision().GetMapRoot().GetMapRoot()
+	mapRoot.Signature = append([]byte{}, mapRoot.Signature...)
+	mapRoot.Signature[len(mapRoot.Signature)-1] ^= 1
+
+	err = VerifyTranscript(transcript)
+	var terr *TranscriptError
+	if !errors.As(err, &terr) {
+		t.Fatalf("VerifyTranscript(): %v, want TranscriptError", err)
+	}
+	want := TranscriptError{Action: i, Desc: action.Desc, RPC: "GetUser", Proof: "map root"}
+	if terr.Action != want.Action || terr.Desc != want.Desc || terr.RPC != want.RPC || terr.Proof != want.Proof {
+		t.Errorf("VerifyTranscript(): %v, want action %v (%v) %v %v", terr, want.Action, want.Desc, want.RPC, want.Proof)
+	}
+}
+
+func TestVerifyTranscriptDetectsLogFork(t *testing.T) {
+	transcript, err := testdata.ReadTranscript("TestForkedLog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = VerifyTranscript(transcript)
+	var terr *TranscriptError
+	if !errors.As(err, &terr) || !errors.Is(err, ErrLogFork) {
+		t.Fatalf("VerifyTranscript(): %v, want %v", err, ErrLogFork)
+	}
+	if got, want := terr.Action, len(transcript.Actions)-1; got != want {
+		t.Errorf("VerifyTranscript(): action %v, want %v", got, want)
+	}
+
+	// Each action verifies on its own.
+	for i, action := range transcript.Actions {
+		single := *transcript
+		single.Actions = []*tpb.Action{action}
+		if err := VerifyTranscript(&single); err != nil {
+			t.Errorf("VerifyTranscript(action %v): %v", i, err)
+		}
+	}
+}
+
+func TestVerifyTranscriptUnverifiedLastVerified(t *testing.T) {
+	transcript, err := testdata.ReadTranscript("TestEmptyGetAndUpdate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const i = 2
+	lastVerified := transcript.Actions[i].GetGetUser().GetRequest().GetLastVerified()
+	lastVerified.RootHash = append([]byte{}, lastVerified.RootHash...)
+	lastVerified.RootHash[0] ^= 1
+
+	err = VerifyTranscript(transcript)
+	var terr *TranscriptError
+	if !errors.As(err, &terr) || !errors.Is(err, ErrUnverifiedLastVerified) {
+		t.Fatalf("VerifyTranscript(): %v, want %v", err, ErrUnverifiedLastVerified)
+	}
+	if terr.Action != i {
+		t.Errorf("VerifyTranscript(): action %v, want %v", terr.Action, i)
+	}
+}
+
 func RunTranscriptTest(t *testing.T, transcript *tpb.Transcript) {
 	t.Helper()
 
@@ -69,3 +155,29 @@ func RunTranscriptTest(t *testing.T, transcript *tpb.Transcript) {
 		})
 	}
 }
+
+func TestVerifyFreshness(t *testing.T) {
+	now := time.Now()
+	for _, tc := range []struct {
+		desc        string
+		maxInterval time.Duration
+		age         time.Duration
+		wantErr     error
+	}{
+		{desc: "disabled", age: 100 * time.Hour},
+		{desc: "fresh", maxInterval: time.Hour, age: time.Minute},
+		{desc: "within skew", maxInterval: time.Hour, age: time.Hour + time.Minute},
+		{desc: "stale", maxInterval: time.Hour, age: 2 * time.Hour, wantErr: ErrStaleMapRoot},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			v := New(nil, nil, nil, nil)
+			if tc.maxInterval != 0 {
+				v.RequireFreshness(tc.maxInterval, 5*time.Minute)
+			}
+			mapRoot := &types.MapRootV1{TimestampNanos: uint64(now.Add(-tc.age).UnixNano())}
+			if err := v.VerifyFreshness(mapRoot); !errors.Is(err, tc.wantErr) {
+				t.Errorf("VerifyFreshness(): %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
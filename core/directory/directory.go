@@ -33,7 +33,13 @@ type Directory struct {
 
 	VRFPriv                  proto.Message
 	MinInterval, MaxInterval time.Duration
-	// TODO(gbelvin): specify mutation function
+	// Mutator names the policy that decides which mutations are accepted.
+	Mutator string
+	// AllowReregistration lets new entries replace deleted entries.
+	AllowReregistration bool
+	// AdminKeyset is the serialized tink keyset that signs administrative
+	// overrides.
+	AdminKeyset      []byte
 	Deleted          bool
 	DeletedTimestamp time.Time
 }
@@ -15,41 +15,105 @@
 package fake
 
 import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+
 	"github.com/google/keytransparency/core/monitorstorage"
+
+	mopb "github.com/google/keytransparency/core/api/monitor/v1/monitor_go_proto"
 )
 
+// monitoredDirectory identifies a directory on a Key Transparency server.
+type monitoredDirectory struct {
+	ktURL       string
+	directoryID string
+}
+
 // MonitorStorage is an in-memory store for the monitoring results.
 type MonitorStorage struct {
-	store  map[int64]*monitorstorage.Result
-	latest int64
+	mu            sync.RWMutex
+	store         map[monitoredDirectory]map[int64]*monitorstorage.Result
+	latest        map[monitoredDirectory]int64
+	equivocations map[monitoredDirectory][]*mopb.Equivocation
 }
 
 // NewMonitorStorage returns an in-memory implementation of monitorstorage.Interface.
 func NewMonitorStorage() *MonitorStorage {
 	return &MonitorStorage{
-		store: make(map[int64]*monitorstorage.Result),
+		store:         make(map[monitoredDirectory]map[int64]*monitorstorage.Result),
+		latest:        make(map[monitoredDirectory]int64),
+		equivocations: make(map[monitoredDirectory][]*mopb.Equivocation),
 	}
 }
 
 // Set stores the given data as a MonitoringResult which can be retrieved by Get.
-func (s *MonitorStorage) Set(revision int64, r *monitorstorage.Result) error {
-	if _, ok := s.store[revision]; ok {
+func (s *MonitorStorage) Set(_ context.Context, ktURL, directoryID string, revision int64,
+	r *monitorstorage.Result) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := monitoredDirectory{ktURL: ktURL, directoryID: directoryID}
+	if _, ok := s.store[d][revision]; ok {
 		return monitorstorage.ErrAlreadyStored
 	}
-	s.store[revision] = r
-	s.latest = revision
+	if _, ok := s.store[d]; !ok {
+		s.store[d] = make(map[int64]*monitorstorage.Result)
+	}
+	s.store[d][revision] = r
+	if latest, ok := s.latest[d]; !ok || revision > latest {
+		s.latest[d] = revision
+	}
 	return nil
 }
 
 // Get returns the Result for the given revision. It returns ErrNotFound if the revision does not exist.
-func (s *MonitorStorage) Get(revision int64) (*monitorstorage.Result, error) {
-	if result, ok := s.store[revision]; ok {
+func (s *MonitorStorage) Get(_ context.Context, ktURL, directoryID string, revision int64) (
+	*monitorstorage.Result, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	d := monitoredDirectory{ktURL: ktURL, directoryID: directoryID}
+	if result, ok := s.store[d][revision]; ok {
 		return result, nil
 	}
 	return nil, monitorstorage.ErrNotFound
 }
 
 // LatestRevision is a convenience method to retrieve the latest stored revision.
-func (s *MonitorStorage) LatestRevision() int64 {
-	return s.latest
+// It returns ErrNotFound if no revision has been stored for the directory.
+func (s *MonitorStorage) LatestRevision(_ context.Context, ktURL, directoryID string) (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	latest, ok := s.latest[monitoredDirectory{ktURL: ktURL, directoryID: directoryID}]
+	if !ok {
+		return 0, monitorstorage.ErrNotFound
+	}
+	return latest, nil
+}
+
+// AddEquivocation records an equivocation proof.
+// Proofs are identified by their revision and gossiped map root.
+func (s *MonitorStorage) AddEquivocation(_ context.Context, e *mopb.Equivocation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d := monitoredDirectory{ktURL: e.GetKtUrl(), directoryID: e.GetDirectoryId()}
+	for _, old := range s.equivocations[d] {
+		if old.GetRevision() == e.GetRevision() &&
+			bytes.Equal(old.GetGossipedSmr().GetMapRoot(), e.GetGossipedSmr().GetMapRoot()) {
+			return nil
+		}
+	}
+	s.equivocations[d] = append(s.equivocations[d], e)
+	sort.SliceStable(s.equivocations[d], func(i, j int) bool {
+		return s.equivocations[d][i].GetRevision() < s.equivocations[d][j].GetRevision()
+	})
+	return nil
+}
+
+// Equivocations returns the equivocation proofs recorded for a directory.
+func (s *MonitorStorage) Equivocations(_ context.Context, ktURL, directoryID string) ([]*mopb.Equivocation, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	d := monitoredDirectory{ktURL: ktURL, directoryID: directoryID}
+	return append([]*mopb.Equivocation(nil), s.equivocations[d]...), nil
 }
@@ -0,0 +1,228 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/trillian/crypto/keys/der"
+	"github.com/google/trillian/merkle/hashers"
+	"github.com/google/trillian/trees"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tpb "github.com/google/trillian"
+	tcrypto "github.com/google/trillian/crypto"
+
+	_ "github.com/google/trillian/crypto/keys/der/proto" // Register PrivateKey handler
+	_ "github.com/google/trillian/merkle/coniks"         // Register hasher
+	_ "github.com/google/trillian/merkle/rfc6962"        // Register hasher
+)
+
+// Trillian is an in-memory implementation of the Trillian admin, log, and map
+// APIs. Leaves are integrated as soon as they are added, and roots and proofs
+// are computed and signed with the tree keys just as Trillian would, so
+// verifying clients can be used against it.
+type Trillian struct {
+	mu     sync.Mutex
+	nextID int64
+	trees  map[int64]*fakeTree
+}
+
+// fakeTree holds the state of a single tree.
+type fakeTree struct {
+	config *tpb.Tree
+	signer *tcrypto.Signer
+	// lastTimestamp is the timestamp of the last root that was signed.
+	lastTimestamp uint64
+	log           *logState
+	smap          *mapState
+}
+
+// NewTrillian returns a Trillian without any trees.
+func NewTrillian() *Trillian {
+	return &Trillian{
+		nextID: 1,
+		trees:  make(map[int64]*fakeTree),
+	}
+}
+
+// Admin returns a client for the admin API.
+func (t *Trillian) Admin() tpb.TrillianAdminClient { return &trillianAdmin{t: t} }
+
+// Log returns a client for the log API.
+func (t *Trillian) Log() tpb.TrillianLogClient { return &trillianLog{t: t} }
+
+// Map returns a client for the map API.
+func (t *Trillian) Map() tpb.TrillianMapClient { return &trillianMap{t: t} }
+
+// MapWrite returns a client for the map write API.
+func (t *Trillian) MapWrite() tpb.TrillianMapWriteClient { return &trillianMapWrite{t: t} }
+
+// tree returns the tree called treeID if it is one of treeTypes.
+// t.mu must be held.
+func (t *Trillian) tree(treeID int64, treeTypes ...tpb.TreeType) (*fakeTree, error) {
+	ft, ok := t.trees[treeID]
+	if !ok || ft.config.Deleted {
+		return nil, status.Errorf(codes.NotFound, "tree %v not found", treeID)
+	}
+	for _, tt := range treeTypes {
+		if ft.config.TreeType == tt {
+			return ft, nil
+		}
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "tree %v is a %v, want one of %v", treeID, ft.config.TreeType, treeTypes)
+}
+
+// timestamp returns a root timestamp that is later than the timestamps of all
+// the roots signed before it.
+func (ft *fakeTree) timestamp() uint64 {
+	ts := uint64(time.Now().UnixNano())
+	if ts <= ft.lastTimestamp {
+		ts = ft.lastTimestamp + 1
+	}
+	ft.lastTimestamp = ts
+	return ts
+}
+
+// redact returns a copy of tree without its private key.
+func redact(tree *tpb.Tree) *tpb.Tree {
+	r := proto.Clone(tree).(*tpb.Tree)
+	r.PrivateKey = nil
+	return r
+}
+
+// trillianAdmin implements tpb.TrillianAdminClient.
+type trillianAdmin struct {
+	t *Trillian
+}
+
+// ListTrees returns all the trees, including deleted trees if requested.
+func (a *trillianAdmin) ListTrees(_ context.Context, in *tpb.ListTreesRequest, _ ...grpc.CallOption) (*tpb.ListTreesResponse, error) {
+	a.t.mu.Lock()
+	defer a.t.mu.Unlock()
+	resp := &tpb.ListTreesResponse{}
+	for id := int64(1); id < a.t.nextID; id++ {
+		if ft, ok := a.t.trees[id]; ok && (in.GetShowDeleted() || !ft.config.Deleted) {
+			resp.Tree = append(resp.Tree, redact(ft.config))
+		}
+	}
+	return resp, nil
+}
+
+// GetTree returns the tree, even if it has been deleted.
+func (a *trillianAdmin) GetTree(_ context.Context, in *tpb.GetTreeRequest, _ ...grpc.CallOption) (*tpb.Tree, error) {
+	a.t.mu.Lock()
+	defer a.t.mu.Unlock()
+	ft, ok := a.t.trees[in.GetTreeId()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "tree %v not found", in.GetTreeId())
+	}
+	return redact(ft.config), nil
+}
+
+// CreateTree creates a log, pre-ordered log, or map tree. A private key is
+// generated if in.KeySpec is set.
+func (a *trillianAdmin) CreateTree(ctx context.Context, in *tpb.CreateTreeRequest, _ ...grpc.CallOption) (*tpb.Tree, error) {
+	if in.GetTree() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "a tree is required")
+	}
+	tree := proto.Clone(in.GetTree()).(*tpb.Tree)
+	switch tree.TreeType {
+	case tpb.TreeType_LOG, tpb.TreeType_PREORDERED_LOG:
+		if _, err := hashers.NewLogHasher(tree.HashStrategy); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to create hasher for tree: %v", err)
+		}
+	case tpb.TreeType_MAP:
+		if _, err := hashers.NewMapHasher(tree.HashStrategy); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to create hasher for tree: %v", err)
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tree type: %v", tree.TreeType)
+	}
+
+	if in.GetKeySpec() != nil {
+		if tree.PrivateKey != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "the tree.private_key and key_spec fields are mutually exclusive")
+		}
+		keyProto, err := der.NewProtoFromSpec(in.GetKeySpec())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to generate private key: %v", err)
+		}
+		if tree.PrivateKey, err = ptypes.MarshalAny(keyProto); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal private key: %v", err)
+		}
+	}
+	if tree.PrivateKey == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tree.private_key or key_spec is required")
+	}
+
+	a.t.mu.Lock()
+	defer a.t.mu.Unlock()
+	tree.TreeId = a.t.nextID
+	signer, err := trees.Signer(ctx, tree)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create signer for tree: %v", err)
+	}
+	if tree.PublicKey, err = der.ToPublicProto(signer.Public()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to marshal public key: %v", err)
+	}
+	tree.CreateTime = ptypes.TimestampNow()
+	tree.UpdateTime = tree.CreateTime
+	tree.Deleted = false
+	tree.DeleteTime = nil
+
+	a.t.nextID++
+	a.t.trees[tree.TreeId] = &fakeTree{config: tree, signer: signer}
+	return redact(tree), nil
+}
+
+// UpdateTree is not supported.
+func (a *trillianAdmin) UpdateTree(context.Context, *tpb.UpdateTreeRequest, ...grpc.CallOption) (*tpb.Tree, error) {
+	return nil, status.Errorf(codes.Unimplemented, "UpdateTree is not implemented")
+}
+
+// DeleteTree soft deletes a tree.
+func (a *trillianAdmin) DeleteTree(_ context.Context, in *tpb.DeleteTreeRequest, _ ...grpc.CallOption) (*tpb.Tree, error) {
+	return a.setDeleted(in.GetTreeId(), true)
+}
+
+// UndeleteTree restores a soft deleted tree.
+func (a *trillianAdmin) UndeleteTree(_ context.Context, in *tpb.UndeleteTreeRequest, _ ...grpc.CallOption) (*tpb.Tree, error) {
+	return a.setDeleted(in.GetTreeId(), false)
+}
+
+func (a *trillianAdmin) setDeleted(treeID int64, deleted bool) (*tpb.Tree, error) {
+	a.t.mu.Lock()
+	defer a.t.mu.Unlock()
+	ft, ok := a.t.trees[treeID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "tree %v not found", treeID)
+	}
+	if ft.config.Deleted == deleted {
+		return nil, status.Errorf(codes.FailedPrecondition, "tree %v has deleted = %v", treeID, deleted)
+	}
+	ft.config.Deleted = deleted
+	ft.config.DeleteTime = nil
+	if deleted {
+		ft.config.DeleteTime = ptypes.TimestampNow()
+	}
+	return redact(ft.config), nil
+}
@@ -0,0 +1,377 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/trillian/merkle"
+	"github.com/google/trillian/merkle/hashers"
+	"github.com/google/trillian/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tpb "github.com/google/trillian"
+)
+
+// logState holds the leaves and the latest root of a log.
+type logState struct {
+	hasher hashers.LogHasher
+	tree   *merkle.InMemoryMerkleTree
+	leaves []*tpb.LogLeaf
+	// pending holds leaves that were added beyond the end of the log.
+	pending map[int64]*tpb.LogLeaf
+	// byHash maps Merkle leaf hashes to leaf indexes.
+	byHash map[string][]int64
+	root   *tpb.SignedLogRoot
+	// revision is the revision of root.
+	revision uint64
+}
+
+// trillianLog implements tpb.TrillianLogClient.
+type trillianLog struct {
+	t *Trillian
+}
+
+// logTree returns the initialized log called logID. t.mu must be held.
+func (t *Trillian) logTree(logID int64) (*fakeTree, error) {
+	ft, err := t.tree(logID, tpb.TreeType_LOG, tpb.TreeType_PREORDERED_LOG)
+	if err != nil {
+		return nil, err
+	}
+	if ft.log == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "log %v is not initialized", logID)
+	}
+	return ft, nil
+}
+
+// signLogRoot signs and saves a new root for the current leaves of the log.
+func (ft *fakeTree) signLogRoot() error {
+	l := ft.log
+	root := &types.LogRootV1{
+		TreeSize:       uint64(len(l.leaves)),
+		RootHash:       l.tree.CurrentRoot().Hash(),
+		TimestampNanos: ft.timestamp(),
+		Revision:       l.revision,
+	}
+	slr, err := ft.signer.SignLogRoot(root)
+	if err != nil {
+		return status.Errorf(codes.Internal, "SignLogRoot(): %v", err)
+	}
+	l.root = slr
+	l.revision++
+	return nil
+}
+
+// InitLog creates the empty root of a log.
+func (l *trillianLog) InitLog(_ context.Context, in *tpb.InitLogRequest, _ ...grpc.CallOption) (*tpb.InitLogResponse, error) {
+	l.t.mu.Lock()
+	defer l.t.mu.Unlock()
+	ft, err := l.t.tree(in.GetLogId(), tpb.TreeType_LOG, tpb.TreeType_PREORDERED_LOG)
+	if err != nil {
+		return nil, err
+	}
+	if ft.log != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "log %v is already initialized", in.GetLogId())
+	}
+	hasher, err := hashers.NewLogHasher(ft.config.HashStrategy)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "NewLogHasher(): %v", err)
+	}
+	ft.log = &logState{
+		hasher:  hasher,
+		tree:    merkle.NewInMemoryMerkleTree(hasher),
+		pending: make(map[int64]*tpb.LogLeaf),
+		byHash:  make(map[string][]int64),
+	}
+	if err := ft.signLogRoot(); err != nil {
+		ft.log = nil
+		return nil, err
+	}
+	return &tpb.InitLogResponse{Created: ft.log.root}, nil
+}
+
+// AddSequencedLeaf adds a leaf to a pre-ordered log.
+func (l *trillianLog) AddSequencedLeaf(ctx context.Context, in *tpb.AddSequencedLeafRequest, opts ...grpc.CallOption) (*tpb.AddSequencedLeafResponse, error) {
+	resp, err := l.AddSequencedLeaves(ctx, &tpb.AddSequencedLeavesRequest{
+		LogId:  in.GetLogId(),
+		Leaves: []*tpb.LogLeaf{in.GetLeaf()},
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &tpb.AddSequencedLeafResponse{Result: resp.GetResults()[0]}, nil
+}
+
+// AddSequencedLeaves adds leaves to a pre-ordered log. Leaves are integrated
+// and a new root is signed as soon as there are no gaps before them.
+func (l *trillianLog) AddSequencedLeaves(_ context.Context, in *tpb.AddSequencedLeavesRequest, _ ...grpc.CallOption) (*tpb.AddSequencedLeavesResponse, error) {
+	l.t.mu.Lock()
+	defer l.t.mu.Unlock()
+	ft, err := l.t.logTree(in.GetLogId())
+	if err != nil {
+		return nil, err
+	}
+	if ft.config.TreeType != tpb.TreeType_PREORDERED_LOG {
+		return nil, status.Errorf(codes.FailedPrecondition, "log %v is not pre-ordered", in.GetLogId())
+	}
+	ls := ft.log
+
+	resp := &tpb.AddSequencedLeavesResponse{}
+	now := ptypes.TimestampNow()
+	for _, leaf := range in.GetLeaves() {
+		index := leaf.GetLeafIndex()
+		if index < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "leaf index %v < 0", index)
+		}
+		existing, ok := ls.pending[index]
+		if index < int64(len(ls.leaves)) {
+			existing, ok = ls.leaves[index], true
+		}
+		if ok {
+			resp.Results = append(resp.Results, &tpb.QueuedLogLeaf{
+				Leaf:   existing,
+				Status: status.Newf(codes.AlreadyExists, "leaf %v already exists", index).Proto(),
+			})
+			continue
+		}
+		leaf = proto.Clone(leaf).(*tpb.LogLeaf)
+		leaf.MerkleLeafHash = ls.hasher.HashLeaf(leaf.GetLeafValue())
+		leaf.QueueTimestamp = now
+		ls.pending[index] = leaf
+		resp.Results = append(resp.Results, &tpb.QueuedLogLeaf{Leaf: leaf})
+	}
+
+	integrated := false
+	for {
+		index := int64(len(ls.leaves))
+		leaf, ok := ls.pending[index]
+		if !ok {
+			break
+		}
+		delete(ls.pending, index)
+		leaf.IntegrateTimestamp = ptypes.TimestampNow()
+		ls.tree.AddLeaf(leaf.GetLeafValue())
+		ls.leaves = append(ls.leaves, leaf)
+		ls.byHash[string(leaf.MerkleLeafHash)] = append(ls.byHash[string(leaf.MerkleLeafHash)], index)
+		integrated = true
+	}
+	if integrated {
+		if err := ft.signLogRoot(); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// QueueLeaf is not supported.
+func (l *trillianLog) QueueLeaf(context.Context, *tpb.QueueLeafRequest, ...grpc.CallOption) (*tpb.QueueLeafResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "QueueLeaf is not implemented")
+}
+
+// QueueLeaves is not supported.
+func (l *trillianLog) QueueLeaves(context.Context, *tpb.QueueLeavesRequest, ...grpc.CallOption) (*tpb.QueueLeavesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "QueueLeaves is not implemented")
+}
+
+// inclusionProof returns the audit path of index in the tree of size treeSize.
+func (ls *logState) inclusionProof(index, treeSize int64) (*tpb.Proof, error) {
+	if index < 0 || index >= treeSize {
+		return nil, status.Errorf(codes.InvalidArgument, "leaf index %v, want [0, %v)", index, treeSize)
+	}
+	if treeSize > int64(len(ls.leaves)) {
+		return nil, status.Errorf(codes.InvalidArgument, "tree size %v > log size %v", treeSize, len(ls.leaves))
+	}
+	path := ls.tree.PathToRootAtSnapshot(index+1, treeSize)
+	proof := &tpb.Proof{LeafIndex: index, Hashes: make([][]byte, 0, len(path))}
+	for _, n := range path {
+		proof.Hashes = append(proof.Hashes, n.Value.Hash())
+	}
+	return proof, nil
+}
+
+// consistencyProof returns the proof that the tree of size first is a prefix
+// of the tree of size second.
+func (ls *logState) consistencyProof(first, second int64) (*tpb.Proof, error) {
+	if first < 0 || first > second {
+		return nil, status.Errorf(codes.InvalidArgument, "tree sizes %v, %v, want 0 <= first <= second", first, second)
+	}
+	if second > int64(len(ls.leaves)) {
+		return nil, status.Errorf(codes.InvalidArgument, "tree size %v > log size %v", second, len(ls.leaves))
+	}
+	path := ls.tree.SnapshotConsistency(first, second)
+	proof := &tpb.Proof{LeafIndex: -1, Hashes: make([][]byte, 0, len(path))}
+	for _, n := range path {
+		proof.Hashes = append(proof.Hashes, n.Value.Hash())
+	}
+	return proof, nil
+}
+
+// GetInclusionProof returns the audit path of a leaf.
+func (l *trillianLog) GetInclusionProof(_ context.Context, in *tpb.GetInclusionProofRequest, _ ...grpc.CallOption) (*tpb.GetInclusionProofResponse, error) {
+	l.t.mu.Lock()
+	defer l.t.mu.Unlock()
+	ft, err := l.t.logTree(in.GetLogId())
+	if err != nil {
+		return nil, err
+	}
+	proof, err := ft.log.inclusionProof(in.GetLeafIndex(), in.GetTreeSize())
+	if err != nil {
+		return nil, err
+	}
+	return &tpb.GetInclusionProofResponse{Proof: proof, SignedLogRoot: ft.log.root}, nil
+}
+
+// GetInclusionProofByHash returns the audit paths of the leaves with a Merkle
+// leaf hash.
+func (l *trillianLog) GetInclusionProofByHash(_ context.Context, in *tpb.GetInclusionProofByHashRequest, _ ...grpc.CallOption) (*tpb.GetInclusionProofByHashResponse, error) {
+	l.t.mu.Lock()
+	defer l.t.mu.Unlock()
+	ft, err := l.t.logTree(in.GetLogId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &tpb.GetInclusionProofByHashResponse{SignedLogRoot: ft.log.root}
+	for _, index := range ft.log.byHash[string(in.GetLeafHash())] {
+		if index >= in.GetTreeSize() {
+			continue
+		}
+		proof, err := ft.log.inclusionProof(index, in.GetTreeSize())
+		if err != nil {
+			return nil, err
+		}
+		resp.Proof = append(resp.Proof, proof)
+	}
+	if len(resp.Proof) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no leaf with hash %x in the first %v leaves", in.GetLeafHash(), in.GetTreeSize())
+	}
+	return resp, nil
+}
+
+// GetConsistencyProof returns a proof that two versions of the log are
+// consistent.
+func (l *trillianLog) GetConsistencyProof(_ context.Context, in *tpb.GetConsistencyProofRequest, _ ...grpc.CallOption) (*tpb.GetConsistencyProofResponse, error) {
+	l.t.mu.Lock()
+	defer l.t.mu.Unlock()
+	ft, err := l.t.logTree(in.GetLogId())
+	if err != nil {
+		return nil, err
+	}
+	proof, err := ft.log.consistencyProof(in.GetFirstTreeSize(), in.GetSecondTreeSize())
+	if err != nil {
+		return nil, err
+	}
+	return &tpb.GetConsistencyProofResponse{Proof: proof, SignedLogRoot: ft.log.root}, nil
+}
+
+// GetLatestSignedLogRoot returns the latest root of the log, along with a
+// consistency proof from in.FirstTreeSize if it is set.
+func (l *trillianLog) GetLatestSignedLogRoot(_ context.Context, in *tpb.GetLatestSignedLogRootRequest, _ ...grpc.CallOption) (*tpb.GetLatestSignedLogRootResponse, error) {
+	l.t.mu.Lock()
+	defer l.t.mu.Unlock()
+	ft, err := l.t.logTree(in.GetLogId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &tpb.GetLatestSignedLogRootResponse{SignedLogRoot: ft.log.root}
+	if first := in.GetFirstTreeSize(); first > 0 {
+		if resp.Proof, err = ft.log.consistencyProof(first, int64(len(ft.log.leaves))); err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// GetSequencedLeafCount returns the number of leaves in the log.
+func (l *trillianLog) GetSequencedLeafCount(_ context.Context, in *tpb.GetSequencedLeafCountRequest, _ ...grpc.CallOption) (*tpb.GetSequencedLeafCountResponse, error) {
+	l.t.mu.Lock()
+	defer l.t.mu.Unlock()
+	ft, err := l.t.logTree(in.GetLogId())
+	if err != nil {
+		return nil, err
+	}
+	return &tpb.GetSequencedLeafCountResponse{LeafCount: int64(len(ft.log.leaves))}, nil
+}
+
+// GetEntryAndProof returns a leaf and its audit path.
+func (l *trillianLog) GetEntryAndProof(_ context.Context, in *tpb.GetEntryAndProofRequest, _ ...grpc.CallOption) (*tpb.GetEntryAndProofResponse, error) {
+	l.t.mu.Lock()
+	defer l.t.mu.Unlock()
+	ft, err := l.t.logTree(in.GetLogId())
+	if err != nil {
+		return nil, err
+	}
+	proof, err := ft.log.inclusionProof(in.GetLeafIndex(), in.GetTreeSize())
+	if err != nil {
+		return nil, err
+	}
+	return &tpb.GetEntryAndProofResponse{
+		Proof:         proof,
+		Leaf:          proto.Clone(ft.log.leaves[in.GetLeafIndex()]).(*tpb.LogLeaf),
+		SignedLogRoot: ft.log.root,
+	}, nil
+}
+
+// GetLeavesByIndex returns the leaves at the given indexes.
+func (l *trillianLog) GetLeavesByIndex(_ context.Context, in *tpb.GetLeavesByIndexRequest, _ ...grpc.CallOption) (*tpb.GetLeavesByIndexResponse, error) {
+	l.t.mu.Lock()
+	defer l.t.mu.Unlock()
+	ft, err := l.t.logTree(in.GetLogId())
+	if err != nil {
+		return nil, err
+	}
+	resp := &tpb.GetLeavesByIndexResponse{SignedLogRoot: ft.log.root}
+	for _, index := range in.GetLeafIndex() {
+		if index < 0 || index >= int64(len(ft.log.leaves)) {
+			return nil, status.Errorf(codes.OutOfRange, "leaf index %v, want [0, %v)", index, len(ft.log.leaves))
+		}
+		resp.Leaves = append(resp.Leaves, proto.Clone(ft.log.leaves[index]).(*tpb.LogLeaf))
+	}
+	return resp, nil
+}
+
+// GetLeavesByRange returns up to in.Count leaves starting at in.StartIndex.
+func (l *trillianLog) GetLeavesByRange(_ context.Context, in *tpb.GetLeavesByRangeRequest, _ ...grpc.CallOption) (*tpb.GetLeavesByRangeResponse, error) {
+	l.t.mu.Lock()
+	defer l.t.mu.Unlock()
+	ft, err := l.t.logTree(in.GetLogId())
+	if err != nil {
+		return nil, err
+	}
+	start, size := in.GetStartIndex(), int64(len(ft.log.leaves))
+	if start < 0 || in.GetCount() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "start index %v, count %v", start, in.GetCount())
+	}
+	if start >= size {
+		return nil, status.Errorf(codes.OutOfRange, "start index %v >= log size %v", start, size)
+	}
+	end := start + in.GetCount()
+	if end > size {
+		end = size
+	}
+	resp := &tpb.GetLeavesByRangeResponse{SignedLogRoot: ft.log.root}
+	for _, leaf := range ft.log.leaves[start:end] {
+		resp.Leaves = append(resp.Leaves, proto.Clone(leaf).(*tpb.LogLeaf))
+	}
+	return resp, nil
+}
+
+// GetLeavesByHash is not supported.
+func (l *trillianLog) GetLeavesByHash(context.Context, *tpb.GetLeavesByHashRequest, ...grpc.CallOption) (*tpb.GetLeavesByHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "GetLeavesByHash is not implemented")
+}
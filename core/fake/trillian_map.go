@@ -0,0 +1,422 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sort"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/trillian/merkle/hashers"
+	"github.com/google/trillian/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tpb "github.com/google/trillian"
+)
+
+// mapState holds every revision of a map.
+type mapState struct {
+	hasher    hashers.MapHasher
+	revisions []*mapRevision
+}
+
+// mapRevision is a snapshot of a sparse Merkle tree.
+type mapRevision struct {
+	root *tpb.SignedMapRoot
+	// leaves are sorted by index.
+	leaves []*tpb.MapLeaf
+	// nodes holds the hashes of the non-empty nodes, keyed by nodeKey.
+	nodes map[string][]byte
+}
+
+// trillianMap implements tpb.TrillianMapClient.
+type trillianMap struct {
+	t *Trillian
+}
+
+// trillianMapWrite implements tpb.TrillianMapWriteClient.
+type trillianMapWrite struct {
+	t *Trillian
+}
+
+// mapTree returns the initialized map called mapID. t.mu must be held.
+func (t *Trillian) mapTree(mapID int64) (*fakeTree, error) {
+	ft, err := t.tree(mapID, tpb.TreeType_MAP)
+	if err != nil {
+		return nil, err
+	}
+	if ft.smap == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "map %v is not initialized", mapID)
+	}
+	return ft, nil
+}
+
+// revision returns revision rev of the map, or the latest revision if rev is
+// negative.
+func (ms *mapState) revision(rev int64) (*mapRevision, error) {
+	if rev < 0 {
+		return ms.revisions[len(ms.revisions)-1], nil
+	}
+	if rev >= int64(len(ms.revisions)) {
+		return nil, status.Errorf(codes.NotFound, "map revision %v not found", rev)
+	}
+	return ms.revisions[rev], nil
+}
+
+// validateIndexes checks that all the indexes are unique and have the bit
+// length of the map hasher.
+func (ms *mapState) validateIndexes(indexes [][]byte) error {
+	seen := make(map[string]bool)
+	for i, index := range indexes {
+		if got, want := len(index)*8, ms.hasher.BitLen(); got != want {
+			return status.Errorf(codes.InvalidArgument, "index %d has %d bits, want %d", i, got, want)
+		}
+		if seen[string(index)] {
+			return status.Errorf(codes.InvalidArgument, "duplicate index at position %d", i)
+		}
+		seen[string(index)] = true
+	}
+	return nil
+}
+
+// bit returns the bit of index at depth, counting from the most significant bit.
+func bit(index []byte, depth int) uint {
+	return uint(index[depth/8]>>(7-uint(depth%8))) & 1
+}
+
+// withBit returns a copy of index with the bit at depth set to b.
+func withBit(index []byte, depth int, b uint) []byte {
+	r := append([]byte(nil), index...)
+	mask := byte(1) << (7 - uint(depth%8))
+	if b == 0 {
+		r[depth/8] &^= mask
+	} else {
+		r[depth/8] |= mask
+	}
+	return r
+}
+
+// nodeKey identifies the node at depth on the path to index.
+func nodeKey(index []byte, depth int) string {
+	prefixLen := (depth + 7) / 8
+	key := make([]byte, 2+prefixLen)
+	binary.BigEndian.PutUint16(key, uint16(depth))
+	copy(key[2:], index[:prefixLen])
+	if depth%8 != 0 {
+		key[len(key)-1] &= byte(0xFF) << (8 - uint(depth%8))
+	}
+	return string(key)
+}
+
+// hashSubtree returns the hash of the subtree at depth that contains leaves,
+// which must share their first depth bits and be sorted by index. The hashes
+// of the non-empty nodes in the subtree are saved in nodes.
+func (ms *mapState) hashSubtree(treeID int64, leaves []*tpb.MapLeaf, depth int, nodes map[string][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+	height := ms.hasher.BitLen() - depth
+	var hash []byte
+	if height == 0 {
+		hash = leaves[0].LeafHash
+	} else {
+		split := sort.Search(len(leaves), func(i int) bool { return bit(leaves[i].Index, depth) == 1 })
+		left := ms.hashSubtree(treeID, leaves[:split], depth+1, nodes)
+		right := ms.hashSubtree(treeID, leaves[split:], depth+1, nodes)
+		if left == nil {
+			left = ms.hasher.HashEmpty(treeID, withBit(leaves[0].Index, depth, 0), height-1)
+		}
+		if right == nil {
+			right = ms.hasher.HashEmpty(treeID, withBit(leaves[0].Index, depth, 1), height-1)
+		}
+		hash = ms.hasher.HashChildren(left, right)
+	}
+	nodes[nodeKey(leaves[0].Index, depth)] = hash
+	return hash
+}
+
+// addRevision signs and saves a new revision with leaves written on top of
+// the latest revision.
+func (ft *fakeTree) addRevision(leaves []*tpb.MapLeaf, metadata []byte) (*mapRevision, error) {
+	ms := ft.smap
+	treeID := ft.config.TreeId
+	byIndex := make(map[string]*tpb.MapLeaf)
+	if n := len(ms.revisions); n > 0 {
+		for _, l := range ms.revisions[n-1].leaves {
+			byIndex[string(l.Index)] = l
+		}
+	}
+	for _, l := range leaves {
+		l = proto.Clone(l).(*tpb.MapLeaf)
+		l.LeafHash = ms.hasher.HashLeaf(treeID, l.Index, l.LeafValue)
+		byIndex[string(l.Index)] = l
+	}
+	mr := &mapRevision{
+		leaves: make([]*tpb.MapLeaf, 0, len(byIndex)),
+		nodes:  make(map[string][]byte),
+	}
+	for _, l := range byIndex {
+		mr.leaves = append(mr.leaves, l)
+	}
+	sort.Slice(mr.leaves, func(i, j int) bool { return bytes.Compare(mr.leaves[i].Index, mr.leaves[j].Index) < 0 })
+
+	rootHash := ms.hashSubtree(treeID, mr.leaves, 0, mr.nodes)
+	if rootHash == nil {
+		rootHash = ms.hasher.HashEmpty(treeID, make([]byte, ms.hasher.Size()), ms.hasher.BitLen())
+	}
+	root, err := ft.signer.SignMapRoot(&types.MapRootV1{
+		RootHash:       rootHash,
+		TimestampNanos: ft.timestamp(),
+		Revision:       uint64(len(ms.revisions)),
+		Metadata:       metadata,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "SignMapRoot(): %v", err)
+	}
+	mr.root = root
+	ms.revisions = append(ms.revisions, mr)
+	return mr, nil
+}
+
+// leaf returns a copy of the leaf at index, or an empty leaf if it has not
+// been set. Copies keep callers from modifying the stored revision.
+func (mr *mapRevision) leaf(index []byte) (*tpb.MapLeaf, bool) {
+	i := sort.Search(len(mr.leaves), func(i int) bool { return bytes.Compare(mr.leaves[i].Index, index) >= 0 })
+	if i < len(mr.leaves) && bytes.Equal(mr.leaves[i].Index, index) {
+		return proto.Clone(mr.leaves[i]).(*tpb.MapLeaf), true
+	}
+	return &tpb.MapLeaf{Index: index}, false
+}
+
+// inclusion returns the leaf at index and its inclusion proof.
+func (mr *mapRevision) inclusion(bitLen int, index []byte) *tpb.MapLeafInclusion {
+	leaf, _ := mr.leaf(index)
+	proof := make([][]byte, bitLen)
+	for depth := 0; depth < bitLen; depth++ {
+		sibling := withBit(index, depth, 1-bit(index, depth))
+		proof[bitLen-1-depth] = mr.nodes[nodeKey(sibling, depth+1)]
+	}
+	return &tpb.MapLeafInclusion{Leaf: leaf, Inclusion: proof}
+}
+
+// InitMap creates the empty revision 0 of a map.
+func (m *trillianMap) InitMap(_ context.Context, in *tpb.InitMapRequest, _ ...grpc.CallOption) (*tpb.InitMapResponse, error) {
+	m.t.mu.Lock()
+	defer m.t.mu.Unlock()
+	ft, err := m.t.tree(in.GetMapId(), tpb.TreeType_MAP)
+	if err != nil {
+		return nil, err
+	}
+	if ft.smap != nil {
+		return nil, status.Errorf(codes.AlreadyExists, "map %v is already initialized", in.GetMapId())
+	}
+	hasher, err := hashers.NewMapHasher(ft.config.HashStrategy)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "NewMapHasher(): %v", err)
+	}
+	ft.smap = &mapState{hasher: hasher}
+	mr, err := ft.addRevision(nil, nil)
+	if err != nil {
+		ft.smap = nil
+		return nil, err
+	}
+	return &tpb.InitMapResponse{Created: mr.root}, nil
+}
+
+// getLeaves returns the leaves at indexes in revision rev, with inclusion
+// proofs. The latest revision is used if rev is negative.
+func (m *trillianMap) getLeaves(mapID, rev int64, indexes [][]byte) (*tpb.GetMapLeavesResponse, error) {
+	m.t.mu.Lock()
+	defer m.t.mu.Unlock()
+	ft, err := m.t.mapTree(mapID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ft.smap.validateIndexes(indexes); err != nil {
+		return nil, err
+	}
+	mr, err := ft.smap.revision(rev)
+	if err != nil {
+		return nil, err
+	}
+	resp := &tpb.GetMapLeavesResponse{MapRoot: mr.root}
+	for _, index := range indexes {
+		resp.MapLeafInclusion = append(resp.MapLeafInclusion, mr.inclusion(ft.smap.hasher.BitLen(), index))
+	}
+	return resp, nil
+}
+
+// getLeavesNoProof returns the leaves that have been set at indexes in
+// revision rev.
+func (t *Trillian) getLeavesNoProof(in *tpb.GetMapLeavesByRevisionRequest) (*tpb.MapLeaves, error) {
+	if in.GetRevision() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "map revision %d must be >= 0", in.GetRevision())
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ft, err := t.mapTree(in.GetMapId())
+	if err != nil {
+		return nil, err
+	}
+	if err := ft.smap.validateIndexes(in.GetIndex()); err != nil {
+		return nil, err
+	}
+	mr, err := ft.smap.revision(in.GetRevision())
+	if err != nil {
+		return nil, err
+	}
+	resp := &tpb.MapLeaves{}
+	for _, index := range in.GetIndex() {
+		if leaf, ok := mr.leaf(index); ok {
+			leaf.LeafHash = nil
+			resp.Leaves = append(resp.Leaves, leaf)
+		}
+	}
+	return resp, nil
+}
+
+// setLeaves writes leaves at revision rev, which must follow the latest
+// revision.
+func (t *Trillian) setLeaves(mapID, rev int64, leaves []*tpb.MapLeaf, metadata []byte) (*tpb.SignedMapRoot, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ft, err := t.mapTree(mapID)
+	if err != nil {
+		return nil, err
+	}
+	indexes := make([][]byte, 0, len(leaves))
+	for _, l := range leaves {
+		indexes = append(indexes, l.GetIndex())
+	}
+	if err := ft.smap.validateIndexes(indexes); err != nil {
+		return nil, err
+	}
+	if want := int64(len(ft.smap.revisions)); rev != want {
+		return nil, status.Errorf(codes.FailedPrecondition, "can't write to revision %v, want %v", rev, want)
+	}
+	mr, err := ft.addRevision(leaves, metadata)
+	if err != nil {
+		return nil, err
+	}
+	return mr.root, nil
+}
+
+// GetLeaf returns a leaf and its inclusion proof in the latest revision.
+func (m *trillianMap) GetLeaf(_ context.Context, in *tpb.GetMapLeafRequest, _ ...grpc.CallOption) (*tpb.GetMapLeafResponse, error) {
+	resp, err := m.getLeaves(in.GetMapId(), -1, [][]byte{in.GetIndex()})
+	if err != nil {
+		return nil, err
+	}
+	return &tpb.GetMapLeafResponse{MapLeafInclusion: resp.MapLeafInclusion[0], MapRoot: resp.MapRoot}, nil
+}
+
+// GetLeafByRevision returns a leaf and its inclusion proof in a revision.
+func (m *trillianMap) GetLeafByRevision(_ context.Context, in *tpb.GetMapLeafByRevisionRequest, _ ...grpc.CallOption) (*tpb.GetMapLeafResponse, error) {
+	if in.GetRevision() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "map revision %d must be >= 0", in.GetRevision())
+	}
+	resp, err := m.getLeaves(in.GetMapId(), in.GetRevision(), [][]byte{in.GetIndex()})
+	if err != nil {
+		return nil, err
+	}
+	return &tpb.GetMapLeafResponse{MapLeafInclusion: resp.MapLeafInclusion[0], MapRoot: resp.MapRoot}, nil
+}
+
+// GetLeaves returns leaves and their inclusion proofs in the latest revision.
+func (m *trillianMap) GetLeaves(_ context.Context, in *tpb.GetMapLeavesRequest, _ ...grpc.CallOption) (*tpb.GetMapLeavesResponse, error) {
+	return m.getLeaves(in.GetMapId(), -1, in.GetIndex())
+}
+
+// GetLeavesByRevision returns leaves and their inclusion proofs in a revision.
+func (m *trillianMap) GetLeavesByRevision(_ context.Context, in *tpb.GetMapLeavesByRevisionRequest, _ ...grpc.CallOption) (*tpb.GetMapLeavesResponse, error) {
+	if in.GetRevision() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "map revision %d must be >= 0", in.GetRevision())
+	}
+	return m.getLeaves(in.GetMapId(), in.GetRevision(), in.GetIndex())
+}
+
+// GetLeavesByRevisionNoProof returns the leaves that have been set in a revision.
+func (m *trillianMap) GetLeavesByRevisionNoProof(_ context.Context, in *tpb.GetMapLeavesByRevisionRequest, _ ...grpc.CallOption) (*tpb.MapLeaves, error) {
+	return m.t.getLeavesNoProof(in)
+}
+
+// GetLastInRangeByRevision is not supported.
+func (m *trillianMap) GetLastInRangeByRevision(context.Context, *tpb.GetLastInRangeByRevisionRequest, ...grpc.CallOption) (*tpb.MapLeaf, error) {
+	return nil, status.Errorf(codes.Unimplemented, "GetLastInRangeByRevision is not implemented")
+}
+
+// SetLeaves writes leaves at in.Revision.
+func (m *trillianMap) SetLeaves(_ context.Context, in *tpb.SetMapLeavesRequest, _ ...grpc.CallOption) (*tpb.SetMapLeavesResponse, error) {
+	if in.GetRevision() <= 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "revision must be > 0")
+	}
+	root, err := m.t.setLeaves(in.GetMapId(), in.GetRevision(), in.GetLeaves(), in.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+	return &tpb.SetMapLeavesResponse{MapRoot: root}, nil
+}
+
+// GetSignedMapRoot returns the latest map root.
+func (m *trillianMap) GetSignedMapRoot(_ context.Context, in *tpb.GetSignedMapRootRequest, _ ...grpc.CallOption) (*tpb.GetSignedMapRootResponse, error) {
+	return m.signedMapRoot(in.GetMapId(), -1)
+}
+
+// GetSignedMapRootByRevision returns the map root of a revision.
+func (m *trillianMap) GetSignedMapRootByRevision(_ context.Context, in *tpb.GetSignedMapRootByRevisionRequest, _ ...grpc.CallOption) (*tpb.GetSignedMapRootResponse, error) {
+	if in.GetRevision() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "map revision %d must be >= 0", in.GetRevision())
+	}
+	return m.signedMapRoot(in.GetMapId(), in.GetRevision())
+}
+
+func (m *trillianMap) signedMapRoot(mapID, rev int64) (*tpb.GetSignedMapRootResponse, error) {
+	m.t.mu.Lock()
+	defer m.t.mu.Unlock()
+	ft, err := m.t.mapTree(mapID)
+	if err != nil {
+		return nil, err
+	}
+	mr, err := ft.smap.revision(rev)
+	if err != nil {
+		return nil, err
+	}
+	return &tpb.GetSignedMapRootResponse{MapRoot: mr.root}, nil
+}
+
+// GetLeavesByRevision returns the leaves that have been set in a revision.
+func (m *trillianMapWrite) GetLeavesByRevision(_ context.Context, in *tpb.GetMapLeavesByRevisionRequest, _ ...grpc.CallOption) (*tpb.MapLeaves, error) {
+	return m.t.getLeavesNoProof(in)
+}
+
+// WriteLeaves writes leaves at in.ExpectRevision.
+func (m *trillianMapWrite) WriteLeaves(_ context.Context, in *tpb.WriteMapLeavesRequest, _ ...grpc.CallOption) (*tpb.WriteMapLeavesResponse, error) {
+	if in.GetExpectRevision() <= 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "revision must be > 0")
+	}
+	root, err := m.t.setLeaves(in.GetMapId(), in.GetExpectRevision(), in.GetLeaves(), in.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+	var mapRoot types.MapRootV1
+	if err := mapRoot.UnmarshalBinary(root.GetMapRoot()); err != nil {
+		return nil, status.Errorf(codes.Internal, "UnmarshalBinary(): %v", err)
+	}
+	return &tpb.WriteMapLeavesResponse{Revision: int64(mapRoot.Revision)}, nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fake
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/trillian/crypto/keyspb"
+	"github.com/google/trillian/crypto/sigpb"
+	"github.com/google/trillian/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tpb "github.com/google/trillian"
+	tclient "github.com/google/trillian/client"
+)
+
+var keySpec = &keyspb.Specification{
+	Params: &keyspb.Specification_EcdsaParams{
+		EcdsaParams: &keyspb.Specification_ECDSA{Curve: keyspb.Specification_ECDSA_P256},
+	},
+}
+
+func createTree(ctx context.Context, t *testing.T, ft *Trillian, treeType tpb.TreeType, hashStrategy tpb.HashStrategy) *tpb.Tree {
+	t.Helper()
+	tree, err := tclient.CreateAndInitTree(ctx, &tpb.CreateTreeRequest{
+		Tree: &tpb.Tree{
+			TreeState:          tpb.TreeState_ACTIVE,
+			TreeType:           treeType,
+			HashStrategy:       hashStrategy,
+			SignatureAlgorithm: sigpb.DigitallySigned_ECDSA,
+			HashAlgorithm:      sigpb.DigitallySigned_SHA256,
+		},
+		KeySpec: keySpec,
+	}, ft.Admin(), ft.Map(), ft.Log())
+	if err != nil {
+		t.Fatalf("CreateAndInitTree(%v): %v", treeType, err)
+	}
+	return tree
+}
+
+func TestTrillianLog(t *testing.T) {
+	ctx := context.Background()
+	ft := NewTrillian()
+	tree := createTree(ctx, t, ft, tpb.TreeType_PREORDERED_LOG, tpb.HashStrategy_RFC6962_SHA256)
+	lc, err := tclient.NewFromTree(ft.Log(), tree, types.LogRootV1{})
+	if err != nil {
+		t.Fatalf("NewFromTree(): %v", err)
+	}
+
+	// Add leaves in batches, out of order, so that every root is verified
+	// against the one before it with a consistency proof.
+	var data [][]byte
+	for _, batch := range [][]int64{{0}, {2, 1}, {3, 4, 5, 6}, {7}} {
+		leaves := make(map[int64][]byte)
+		for _, i := range batch {
+			leaves[i] = []byte(fmt.Sprintf("leaf %d", i))
+		}
+		if err := lc.AddSequencedLeaves(ctx, leaves); err != nil {
+			t.Fatalf("AddSequencedLeaves(%v): %v", batch, err)
+		}
+		root, err := lc.UpdateRoot(ctx)
+		if err != nil {
+			t.Fatalf("UpdateRoot(): %v", err)
+		}
+		data = append(data, make([][]byte, len(batch))...)
+		for _, i := range batch {
+			data[i] = leaves[i]
+		}
+		if got, want := root.TreeSize, uint64(len(data)); got != want {
+			t.Fatalf("TreeSize: %v, want %v", got, want)
+		}
+	}
+	for i, d := range data {
+		if err := lc.VerifyInclusion(ctx, d); err != nil {
+			t.Errorf("VerifyInclusion(%s): %v", d, err)
+		}
+		if err := lc.GetAndVerifyInclusionAtIndex(ctx, d, int64(i), lc.GetRoot()); err != nil {
+			t.Errorf("GetAndVerifyInclusionAtIndex(%v): %v", i, err)
+		}
+	}
+
+	resp, err := ft.Log().AddSequencedLeaf(ctx, &tpb.AddSequencedLeafRequest{
+		LogId: tree.TreeId,
+		Leaf:  &tpb.LogLeaf{LeafIndex: 0, LeafValue: []byte("other")},
+	})
+	if err != nil {
+		t.Fatalf("AddSequencedLeaf(): %v", err)
+	}
+	if got, want := codes.Code(resp.GetResult().GetStatus().GetCode()), codes.AlreadyExists; got != want {
+		t.Errorf("AddSequencedLeaf(0): %v, want %v", got, want)
+	}
+}
+
+func TestTrillianMap(t *testing.T) {
+	ctx := context.Background()
+	ft := NewTrillian()
+	tree := createTree(ctx, t, ft, tpb.TreeType_MAP, tpb.HashStrategy_CONIKS_SHA256)
+	mc, err := tclient.NewMapClientFromTree(ft.Map(), tree)
+	if err != nil {
+		t.Fatalf("NewMapClientFromTree(): %v", err)
+	}
+
+	index := func(b ...byte) []byte { return append(b, make([]byte, 32-len(b))...) }
+	indexes := [][]byte{index(0), index(0x80), index(0x40), index(0, 1), index(0xff, 0xff)}
+	for rev, leaves := range [][]*tpb.MapLeaf{
+		{{Index: indexes[0], LeafValue: []byte("A")}},
+		{{Index: indexes[1], LeafValue: []byte("B")}, {Index: indexes[2], LeafValue: []byte("C")}},
+		{{Index: indexes[0], LeafValue: []byte("D")}, {Index: indexes[3], LeafValue: []byte("E")}},
+	} {
+		if _, err := ft.MapWrite().WriteLeaves(ctx, &tpb.WriteMapLeavesRequest{
+			MapId:          tree.TreeId,
+			Leaves:         leaves,
+			Metadata:       []byte{byte(rev)},
+			ExpectRevision: int64(rev + 1),
+		}); err != nil {
+			t.Fatalf("WriteLeaves(%v): %v", rev+1, err)
+		}
+	}
+	if _, err := ft.MapWrite().WriteLeaves(ctx, &tpb.WriteMapLeavesRequest{
+		MapId:          tree.TreeId,
+		ExpectRevision: 2,
+	}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("WriteLeaves(2): %v, want %v", err, codes.FailedPrecondition)
+	}
+
+	for _, tc := range []struct {
+		rev  int64
+		want []string
+	}{
+		{rev: 0, want: []string{"", "", "", "", ""}},
+		{rev: 1, want: []string{"A", "", "", "", ""}},
+		{rev: 2, want: []string{"A", "B", "C", "", ""}},
+		{rev: 3, want: []string{"D", "B", "C", "E", ""}},
+	} {
+		leaves, root, err := mc.GetAndVerifyMapLeavesByRevision(ctx, tc.rev, indexes)
+		if err != nil {
+			t.Fatalf("GetAndVerifyMapLeavesByRevision(%v): %v", tc.rev, err)
+		}
+		if got := int64(root.Revision); got != tc.rev {
+			t.Errorf("Revision: %v, want %v", got, tc.rev)
+		}
+		if tc.rev > 0 && !bytes.Equal(root.Metadata, []byte{byte(tc.rev - 1)}) {
+			t.Errorf("Metadata: %x, want %x", root.Metadata, tc.rev-1)
+		}
+		for i, l := range leaves {
+			if got := string(l.LeafValue); got != tc.want[i] {
+				t.Errorf("rev %v: leaf %x: %q, want %q", tc.rev, l.Index, got, tc.want[i])
+			}
+		}
+	}
+
+	if _, err := mc.GetAndVerifyMapRootByRevision(ctx, 4); status.Code(err) != codes.NotFound {
+		t.Errorf("GetAndVerifyMapRootByRevision(4): %v, want %v", err, codes.NotFound)
+	}
+	noProof, err := ft.MapWrite().GetLeavesByRevision(ctx, &tpb.GetMapLeavesByRevisionRequest{
+		MapId:    tree.TreeId,
+		Revision: 3,
+		Index:    indexes,
+	})
+	if err != nil {
+		t.Fatalf("GetLeavesByRevision(): %v", err)
+	}
+	if got, want := len(noProof.GetLeaves()), 4; got != want {
+		t.Errorf("GetLeavesByRevision(): %v leaves, want %v", got, want)
+	}
+}
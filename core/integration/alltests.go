@@ -60,6 +60,9 @@ var AllTests = []NamedTestFn{
 	{Name: "TestBatchUpdate", Fn: TestBatchUpdate},
 	{Name: "TestBatchCreate", Fn: TestBatchCreate},
 	{Name: "TestBatchListUserRevisions", Fn: TestBatchListUserRevisions},
+	{Name: "TestValidityPeriod", Fn: TestValidityPeriod},
+	{Name: "TestDeviceValidityPeriod", Fn: TestDeviceValidityPeriod},
+	{Name: "TestFreshness", Fn: TestFreshness},
 	// Monitor Tests
 	{Name: "TestMonitor", Fn: TestMonitor},
 }
@@ -17,15 +17,19 @@ package integration
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"sort"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/golang/protobuf/ptypes"
 	"github.com/google/keytransparency/core/client"
 	"github.com/google/keytransparency/core/client/tracker"
 	"github.com/google/keytransparency/core/client/verifier"
+	"github.com/google/keytransparency/core/mutator/entry"
 	"github.com/google/keytransparency/core/sequencer"
 	"github.com/google/keytransparency/core/testutil"
 	"github.com/google/trillian/types"
@@ -108,6 +112,23 @@ func runSequencer(ctx context.Context, t *testing.T, env *Env) {
 	})
 }
 
+// startSequencer runs the sequencer in the background until the returned
+// function is called. The returned function waits for the sequencer to exit
+// so that it cannot outlive the test or the servers in env.
+func startSequencer(ctx context.Context, t *testing.T, env *Env) func() {
+	t.Helper()
+	cctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		runSequencer(cctx, t, env)
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
 func genUserIDs(count int) []string {
 	userIDs := make([]string, 0, count)
 	for i := 0; i < count; i++ {
@@ -118,7 +139,7 @@ func genUserIDs(count int) []string {
 
 // TestBatchCreate verifies that the batch functions are working correctly.
 func TestBatchCreate(ctx context.Context, env *Env, t *testing.T) []*tpb.Action {
-	go runSequencer(ctx, t, env)
+	defer startSequencer(ctx, t, env)()
 	signers1 := testutil.SignKeysetsFromPEMs(testPrivKey1)
 	authorizedKeys1 := testutil.VerifyKeysetFromPEMs(testPubKey1)
 
@@ -153,7 +174,7 @@ func TestBatchCreate(ctx context.Context, env *Env, t *testing.T) []*tpb.Action
 
 // TestBatchUpdate verifies that the batch functions are working correctly.
 func TestBatchUpdate(ctx context.Context, env *Env, t *testing.T) []*tpb.Action {
-	go runSequencer(ctx, t, env)
+	defer startSequencer(ctx, t, env)()
 	signers1 := testutil.SignKeysetsFromPEMs(testPrivKey1)
 	authorizedKeys1 := testutil.VerifyKeysetFromPEMs(testPubKey1)
 
@@ -191,7 +212,7 @@ func TestBatchUpdate(ctx context.Context, env *Env, t *testing.T) []*tpb.Action
 
 // TestEmptyGetAndUpdate verifies set/get semantics.
 func TestEmptyGetAndUpdate(ctx context.Context, env *Env, t *testing.T) []*tpb.Action {
-	go runSequencer(ctx, t, env)
+	defer startSequencer(ctx, t, env)()
 
 	cli, err := client.NewFromConfig(env.Cli, env.Directory,
 		func(lv *tclient.LogVerifier) verifier.LogTracker {
@@ -338,9 +359,144 @@ func TestEmptyGetAndUpdate(ctx context.Context, env *Env, t *testing.T) []*tpb.A
 	return transcript
 }
 
+// TestValidityPeriod verifies that updates keep the validity period of the
+// current entry unless they replace or clear it.
+func TestValidityPeriod(ctx context.Context, env *Env, t *testing.T) []*tpb.Action {
+	defer startSequencer(ctx, t, env)()
+	signers := testutil.SignKeysetsFromPEMs(testPrivKey1)
+	authorizedKeys := testutil.VerifyKeysetFromPEMs(testPubKey1)
+	userID := "validity"
+	notAfter := time.Now().Add(time.Hour).Truncate(time.Second)
+	later := notAfter.Add(time.Hour)
+
+	for _, tc := range []struct {
+		desc string
+		u    client.User
+		want time.Time
+	}{
+		// Tests are cumulative.
+		{desc: "set", u: client.User{NotAfter: notAfter}, want: notAfter},
+		{desc: "keep", want: notAfter},
+		{desc: "replace", u: client.User{NotAfter: later}, want: later},
+		{desc: "clear", u: client.User{ClearValidityPeriod: true}},
+		{desc: "keep_cleared"},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			u := tc.u
+			u.UserID = userID
+			u.PublicKeyData = []byte(tc.desc)
+			u.AuthorizedKeys = authorizedKeys
+			cctx, cancel := context.WithTimeout(ctx, env.Timeout)
+			defer cancel()
+			if _, err := env.Client.Update(cctx, &u, signers); err != nil {
+				t.Fatalf("Update(%v): %v", userID, err)
+			}
+
+			if got, ok := getNotAfter(ctx, t, env, userID); ok != !tc.want.IsZero() || !got.Equal(tc.want) {
+				t.Errorf("NotAfter(): %v, %v, want %v", got, ok, tc.want)
+			}
+		})
+	}
+	return nil
+}
+
+// TestDeviceValidityPeriod verifies that editing the device list of a profile
+// keeps the validity period of the entry.
+func TestDeviceValidityPeriod(ctx context.Context, env *Env, t *testing.T) []*tpb.Action {
+	defer startSequencer(ctx, t, env)()
+	signers := testutil.SignKeysetsFromPEMs(testPrivKey1)
+	userID := "devices"
+	notAfter := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	profileData, err := proto.Marshal(&pb.Profile{
+		Version: client.ProfileVersion,
+		Devices: []*pb.Device{{Label: "phone", PublicKey: []byte("phone-key")}},
+	})
+	if err != nil {
+		t.Fatalf("proto.Marshal(): %v", err)
+	}
+	cctx, cancel := context.WithTimeout(ctx, env.Timeout)
+	defer cancel()
+	if _, err := env.Client.Update(cctx, &client.User{
+		UserID:         userID,
+		PublicKeyData:  profileData,
+		AuthorizedKeys: testutil.VerifyKeysetFromPEMs(testPubKey1),
+		NotAfter:       notAfter,
+	}, signers); err != nil {
+		t.Fatalf("Update(%v): %v", userID, err)
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		edit   func(ctx context.Context) (*entry.Mutation, error)
+		labels []string
+	}{
+		// Tests are cumulative.
+		{
+			desc: "add",
+			edit: func(ctx context.Context) (*entry.Mutation, error) {
+				return env.Client.AddDevice(ctx, userID, &pb.Device{Label: "laptop"}, signers)
+			},
+			labels: []string{"phone", "laptop"},
+		},
+		{
+			desc: "remove",
+			edit: func(ctx context.Context) (*entry.Mutation, error) {
+				return env.Client.RemoveDevice(ctx, userID, "phone", signers)
+			},
+			labels: []string{"laptop"},
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			cctx, cancel := context.WithTimeout(ctx, env.Timeout)
+			defer cancel()
+			if _, err := tc.edit(cctx); err != nil {
+				t.Fatalf("edit(): %v", err)
+			}
+			if got, ok := getNotAfter(ctx, t, env, userID); !ok || !got.Equal(notAfter) {
+				t.Errorf("NotAfter(): %v, %v, want %v", got, ok, notAfter)
+			}
+			_, data, err := env.Client.GetUser(ctx, userID)
+			if err != nil {
+				t.Fatalf("GetUser(%v): %v", userID, err)
+			}
+			p, err := client.ParseProfile(data)
+			if err != nil {
+				t.Fatalf("ParseProfile(): %v", err)
+			}
+			var labels []string
+			for _, d := range p.GetDevices() {
+				labels = append(labels, d.GetLabel())
+			}
+			if !reflect.DeepEqual(labels, tc.labels) {
+				t.Errorf("devices: %v, want %v", labels, tc.labels)
+			}
+		})
+	}
+	return nil
+}
+
+// getNotAfter returns the not_after time of the current entry of userID.
+func getNotAfter(ctx context.Context, t *testing.T, env *Env, userID string) (time.Time, bool) {
+	t.Helper()
+	_, leaf, err := env.Client.VerifiedGetUser(ctx, userID)
+	if err != nil {
+		t.Fatalf("VerifiedGetUser(%v): %v", userID, err)
+	}
+	signed, err := entry.FromLeafValue(leaf.GetMapInclusion().GetLeaf().GetLeafValue())
+	if err != nil {
+		t.Fatalf("FromLeafValue(): %v", err)
+	}
+	var e pb.Entry
+	if err := proto.Unmarshal(signed.GetEntry(), &e); err != nil {
+		t.Fatalf("proto.Unmarshal(): %v", err)
+	}
+	return entry.NotAfter(&e)
+}
+
 // TestBatchGetUser tests fetching multiple users in a single request.
 func TestBatchGetUser(ctx context.Context, env *Env, t *testing.T) []*tpb.Action {
-	go runSequencer(ctx, t, env)
+	defer startSequencer(ctx, t, env)()
 	signers1 := testutil.SignKeysetsFromPEMs(testPrivKey1)
 	authorizedKeys1 := testutil.VerifyKeysetFromPEMs(testPubKey1)
 	transcript := []*tpb.Action{}
@@ -668,3 +824,36 @@ func (m uint64Slice) Less(i, j int) bool { return m[i] < m[j] }
 func cp(tag int) []byte {
 	return []byte(fmt.Sprintf("bar%v", tag))
 }
+
+// TestFreshness tests that a client can require recent map roots.
+func TestFreshness(ctx context.Context, env *Env, t *testing.T) []*tpb.Action {
+	defer startSequencer(ctx, t, env)()
+	cli, err := client.NewFromConfig(env.Cli, env.Directory,
+		func(lv *tclient.LogVerifier) verifier.LogTracker { return tracker.NewSynchronous(lv) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	maxInterval, err := ptypes.Duration(env.Directory.GetMaxInterval())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		desc        string
+		maxInterval time.Duration
+		wantErr     error
+	}{
+		{desc: "fresh", maxInterval: maxInterval},
+		{desc: "stale", maxInterval: time.Nanosecond, wantErr: verifier.ErrStaleMapRoot},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			cli.RequireFreshness(tc.maxInterval, 0)
+			cctx, cancel := context.WithTimeout(ctx, env.Timeout)
+			defer cancel()
+			if _, _, err := cli.GetUser(cctx, "alice"); !errors.Is(err, tc.wantErr) {
+				t.Errorf("GetUser(): %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+	return nil
+}
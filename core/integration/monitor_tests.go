@@ -41,6 +41,7 @@ MHcCAQEEIAV7H3qRi/cj/w04vEQBFjLdhcXRbZR4ouT5zaAy1XUHoAoGCCqGSM49
 AwEHoUQDQgAEqUDbATN2maGIm6YQLpjx67bYN1hxPPdF0VrPTZe36yQhH+GCwZQV
 amFdON6OhjYnBmJWe4fVnbxny0PfpkvXtg==
 -----END EC PRIVATE KEY-----`
+	monitorKTURL = "keytransparency.test"
 )
 
 // TestMonitor verifies that the monitor correctly verifies transitions between revisions.
@@ -52,7 +53,7 @@ func TestMonitor(ctx context.Context, env *Env, t *testing.T) []*tpb.Action {
 	}
 	signer := tcrypto.NewSigner(0, privKey, crypto.SHA256)
 	store := fake.NewMonitorStorage()
-	mon, err := monitor.NewFromDirectory(env.Cli, env.Directory, signer, store)
+	mon, err := monitor.NewFromDirectory(monitorKTURL, env.Cli, env.Directory, signer, store)
 	if err != nil {
 		t.Fatalf("Couldn't create monitor: %v", err)
 	}
@@ -128,7 +129,7 @@ func TestMonitor(ctx context.Context, env *Env, t *testing.T) []*tpb.Action {
 	}
 
 	for i := int64(1); i < 4; i++ {
-		mresp, err := store.Get(i)
+		mresp, err := store.Get(ctx, monitorKTURL, env.Directory.DirectoryId, i)
 		if err != nil {
 			t.Errorf("Could not read monitoring response for revision %v: %v", i, err)
 			continue
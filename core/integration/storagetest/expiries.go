@@ -0,0 +1,138 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagetest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/keytransparency/core/sequencer"
+
+	spb "github.com/google/keytransparency/core/sequencer/sequencer_go_proto"
+)
+
+// expiriesFactory returns a new expiry storage object.
+type expiriesFactory func(ctx context.Context, t *testing.T, dirID string) sequencer.Expiries
+
+type ExpiriesTest func(ctx context.Context, t *testing.T, f expiriesFactory)
+
+// RunExpiriesTests runs all the expiry storage tests against the provided storage implementation.
+func RunExpiriesTests(t *testing.T, factory expiriesFactory) {
+	ctx := context.Background()
+	e := &ExpiriesTests{}
+	for name, f := range map[string]ExpiriesTest{
+		"TestReadExpired":         e.TestReadExpired,
+		"TestOverwriteExpiry":     e.TestOverwriteExpiry,
+		"TestExpiriesByDirectory": e.TestExpiriesByDirectory,
+	} {
+		t.Run(name, func(t *testing.T) { f(ctx, t, factory) })
+	}
+}
+
+// ExpiriesTests is a suite of tests to run against sequencer.Expiries.
+type ExpiriesTests struct{}
+
+var epoch = time.Unix(1000, 0)
+
+// expiry returns an expiry for index that is hours after epoch, or an expiry
+// without a not_after time if hours is 0.
+func expiry(t *testing.T, index byte, hours int) *spb.RevisionMetadata_Expiry {
+	t.Helper()
+	x := &spb.RevisionMetadata_Expiry{Index: []byte{index}}
+	if hours == 0 {
+		return x
+	}
+	notAfter, err := ptypes.TimestampProto(epoch.Add(time.Duration(hours) * time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	x.NotAfter = notAfter
+	return x
+}
+
+func readExpired(ctx context.Context, t *testing.T, e sequencer.Expiries, dirID string,
+	start, end int, want []*spb.RevisionMetadata_Expiry) {
+	t.Helper()
+	got, err := e.ReadExpired(ctx, dirID,
+		epoch.Add(time.Duration(start)*time.Hour), epoch.Add(time.Duration(end)*time.Hour))
+	if err != nil {
+		t.Fatalf("ReadExpired(%v, %v): %v", start, end, err)
+	}
+	if !cmp.Equal(got, want, cmp.Comparer(proto.Equal), cmpopts.EquateEmpty()) {
+		t.Errorf("ReadExpired(%v, %v): %v, want %v", start, end, got, want)
+	}
+}
+
+func (*ExpiriesTests) TestReadExpired(ctx context.Context, t *testing.T, f expiriesFactory) {
+	dirID := "readexpiredtest"
+	e := f(ctx, t, dirID)
+	if err := e.WriteExpiries(ctx, dirID, []*spb.RevisionMetadata_Expiry{
+		expiry(t, 3, 2),
+		expiry(t, 1, 1),
+		expiry(t, 2, 2),
+		expiry(t, 4, 0),
+	}); err != nil {
+		t.Fatalf("WriteExpiries(): %v", err)
+	}
+	for _, tc := range []struct {
+		start, end int
+		want       []*spb.RevisionMetadata_Expiry
+	}{
+		{start: 0, end: 1},
+		{start: 0, end: 2, want: []*spb.RevisionMetadata_Expiry{expiry(t, 1, 1)}},
+		{start: 2, end: 3, want: []*spb.RevisionMetadata_Expiry{expiry(t, 2, 2), expiry(t, 3, 2)}},
+		{start: 0, end: 10, want: []*spb.RevisionMetadata_Expiry{expiry(t, 1, 1), expiry(t, 2, 2), expiry(t, 3, 2)}},
+		{start: 3, end: 10},
+	} {
+		readExpired(ctx, t, e, dirID, tc.start, tc.end, tc.want)
+	}
+}
+
+func (*ExpiriesTests) TestOverwriteExpiry(ctx context.Context, t *testing.T, f expiriesFactory) {
+	dirID := "overwriteexpirytest"
+	e := f(ctx, t, dirID)
+	for _, tc := range []struct {
+		write *spb.RevisionMetadata_Expiry
+		want  []*spb.RevisionMetadata_Expiry
+	}{
+		// Tests are cumulative.
+		{write: expiry(t, 1, 1), want: []*spb.RevisionMetadata_Expiry{expiry(t, 1, 1)}},
+		{write: expiry(t, 1, 5), want: []*spb.RevisionMetadata_Expiry{expiry(t, 1, 5)}},
+		{write: expiry(t, 1, 0)},
+		{write: expiry(t, 1, 0)},
+	} {
+		if err := e.WriteExpiries(ctx, dirID, []*spb.RevisionMetadata_Expiry{tc.write}); err != nil {
+			t.Fatalf("WriteExpiries(%v): %v", tc.write, err)
+		}
+		readExpired(ctx, t, e, dirID, 0, 10, tc.want)
+	}
+}
+
+func (*ExpiriesTests) TestExpiriesByDirectory(ctx context.Context, t *testing.T, f expiriesFactory) {
+	dirID := "expiriesbydirectorytest"
+	e := f(ctx, t, dirID)
+	if err := e.WriteExpiries(ctx, dirID, []*spb.RevisionMetadata_Expiry{expiry(t, 1, 1)}); err != nil {
+		t.Fatalf("WriteExpiries(): %v", err)
+	}
+	if err := e.WriteExpiries(ctx, "otherdirectory", []*spb.RevisionMetadata_Expiry{expiry(t, 2, 1)}); err != nil {
+		t.Fatalf("WriteExpiries(): %v", err)
+	}
+	readExpired(ctx, t, e, dirID, 0, 10, []*spb.RevisionMetadata_Expiry{expiry(t, 1, 1)})
+}
@@ -0,0 +1,208 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storagetest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/keytransparency/core/monitorstorage"
+	"github.com/google/trillian"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mopb "github.com/google/keytransparency/core/api/monitor/v1/monitor_go_proto"
+)
+
+// monitorStorageFactory returns a new, empty, monitor storage object.
+type monitorStorageFactory func(ctx context.Context, t *testing.T) monitorstorage.Interface
+
+// MonitorStorageTest is a single test in the monitor storage suite.
+type MonitorStorageTest func(ctx context.Context, t *testing.T, f monitorStorageFactory)
+
+// RunMonitorStorageTests runs all the monitor storage tests against the provided storage implementation.
+func RunMonitorStorageTests(t *testing.T, factory monitorStorageFactory) {
+	ctx := context.Background()
+	m := &MonitorTests{}
+	for name, f := range map[string]MonitorStorageTest{
+		"TestGetNotFound":        m.TestGetNotFound,
+		"TestSetGet":             m.TestSetGet,
+		"TestLatestRevision":     m.TestLatestRevision,
+		"TestDirectoryIsolation": m.TestDirectoryIsolation,
+		"TestEquivocations":      m.TestEquivocations,
+	} {
+		t.Run(name, func(t *testing.T) { f(ctx, t, factory) })
+	}
+}
+
+// MonitorTests is a suite of tests to run against monitorstorage.Interface implementations.
+type MonitorTests struct{}
+
+func (*MonitorTests) TestGetNotFound(ctx context.Context, t *testing.T, f monitorStorageFactory) {
+	m := f(ctx, t)
+	if _, err := m.Get(ctx, "kt", "dir", 1); err != monitorstorage.ErrNotFound {
+		t.Errorf("Get(): %v, want %v", err, monitorstorage.ErrNotFound)
+	}
+	if _, err := m.LatestRevision(ctx, "kt", "dir"); err != monitorstorage.ErrNotFound {
+		t.Errorf("LatestRevision(): %v, want %v", err, monitorstorage.ErrNotFound)
+	}
+}
+
+func (*MonitorTests) TestSetGet(ctx context.Context, t *testing.T, f monitorStorageFactory) {
+	m := f(ctx, t)
+	seen := time.Unix(1581000000, 1234)
+	for _, tc := range []struct {
+		desc     string
+		revision int64
+		result   *monitorstorage.Result
+		wantErr  error
+	}{
+		// Tests are cumulative.
+		{desc: "signed", revision: 1, result: &monitorstorage.Result{
+			Smr:       &trillian.SignedMapRoot{MapRoot: []byte("root"), Signature: []byte("sig")},
+			ServerSmr: &trillian.SignedMapRoot{MapRoot: []byte("root"), Signature: []byte("serversig")},
+			Seen:      seen,
+		}},
+		{desc: "errors", revision: 2, result: &monitorstorage.Result{
+			Seen: seen,
+			Errors: []error{
+				status.Errorf(codes.DataLoss, "bad mutation"),
+				errors.New("plain error"),
+			},
+		}},
+		{desc: "duplicate", revision: 1, wantErr: monitorstorage.ErrAlreadyStored, result: &monitorstorage.Result{
+			Seen: seen,
+		}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := m.Set(ctx, "kt", "dir", tc.revision, tc.result)
+			if err != tc.wantErr {
+				t.Fatalf("Set(): %v, want %v", err, tc.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			got, err := m.Get(ctx, "kt", "dir", tc.revision)
+			if err != nil {
+				t.Fatalf("Get(): %v", err)
+			}
+			gotPB, err := got.Proto()
+			if err != nil {
+				t.Fatalf("Proto(): %v", err)
+			}
+			wantPB, err := tc.result.Proto()
+			if err != nil {
+				t.Fatalf("Proto(): %v", err)
+			}
+			if !proto.Equal(gotPB, wantPB) {
+				t.Errorf("Get(): %v, want %v", gotPB, wantPB)
+			}
+		})
+	}
+}
+
+func (*MonitorTests) TestLatestRevision(ctx context.Context, t *testing.T, f monitorStorageFactory) {
+	m := f(ctx, t)
+	for _, tc := range []struct {
+		revision int64
+		want     int64
+	}{
+		// Tests are cumulative.
+		{revision: 2, want: 2},
+		{revision: 1, want: 2},
+		{revision: 10, want: 10},
+	} {
+		if err := m.Set(ctx, "kt", "dir", tc.revision, &monitorstorage.Result{Seen: time.Unix(0, 0)}); err != nil {
+			t.Fatalf("Set(%v): %v", tc.revision, err)
+		}
+		got, err := m.LatestRevision(ctx, "kt", "dir")
+		if err != nil {
+			t.Fatalf("LatestRevision(): %v", err)
+		}
+		if got != tc.want {
+			t.Errorf("LatestRevision(): %v, want %v", got, tc.want)
+		}
+	}
+}
+
+func (*MonitorTests) TestDirectoryIsolation(ctx context.Context, t *testing.T, f monitorStorageFactory) {
+	m := f(ctx, t)
+	for _, d := range []struct {
+		ktURL, directoryID string
+		revision           int64
+	}{
+		{ktURL: "kt1", directoryID: "dir", revision: 5},
+		{ktURL: "kt2", directoryID: "dir", revision: 3},
+		{ktURL: "kt1", directoryID: "other", revision: 7},
+	} {
+		if err := m.Set(ctx, d.ktURL, d.directoryID, d.revision, &monitorstorage.Result{Seen: time.Unix(0, 0)}); err != nil {
+			t.Fatalf("Set(%v/%v, %v): %v", d.ktURL, d.directoryID, d.revision, err)
+		}
+		got, err := m.LatestRevision(ctx, d.ktURL, d.directoryID)
+		if err != nil {
+			t.Fatalf("LatestRevision(%v/%v): %v", d.ktURL, d.directoryID, err)
+		}
+		if got != d.revision {
+			t.Errorf("LatestRevision(%v/%v): %v, want %v", d.ktURL, d.directoryID, got, d.revision)
+		}
+	}
+	if _, err := m.Get(ctx, "kt2", "dir", 5); err != monitorstorage.ErrNotFound {
+		t.Errorf("Get(kt2/dir, 5): %v, want %v", err, monitorstorage.ErrNotFound)
+	}
+	if _, err := m.Get(ctx, "kt1", "dir", 5); err != nil {
+		t.Errorf("Get(kt1/dir, 5): %v", err)
+	}
+}
+
+func (*MonitorTests) TestEquivocations(ctx context.Context, t *testing.T, f monitorStorageFactory) {
+	m := f(ctx, t)
+	equivocation := func(ktURL, directoryID string, revision int64, gossiped string) *mopb.Equivocation {
+		return &mopb.Equivocation{
+			KtUrl:       ktURL,
+			DirectoryId: directoryID,
+			Revision:    revision,
+			ObservedSmr: &trillian.SignedMapRoot{MapRoot: []byte("observed"), Signature: []byte("sig1")},
+			GossipedSmr: &trillian.SignedMapRoot{MapRoot: []byte(gossiped), Signature: []byte("sig2")},
+		}
+	}
+	e1 := equivocation("kt", "dir", 2, "gossiped1")
+	e2 := equivocation("kt", "dir", 1, "gossiped1")
+	e3 := equivocation("kt", "dir", 3, "gossiped2")
+	other := equivocation("kt", "other", 1, "gossiped1")
+
+	got, err := m.Equivocations(ctx, "kt", "dir")
+	if err != nil {
+		t.Fatalf("Equivocations(): %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("Equivocations(): %v, want none", got)
+	}
+	for _, e := range []*mopb.Equivocation{e1, e2, e1, e3, other} {
+		if err := m.AddEquivocation(ctx, e); err != nil {
+			t.Fatalf("AddEquivocation(%v): %v", e, err)
+		}
+	}
+	got, err = m.Equivocations(ctx, "kt", "dir")
+	if err != nil {
+		t.Fatalf("Equivocations(): %v", err)
+	}
+	if want := []*mopb.Equivocation{e2, e1, e3}; !cmp.Equal(got, want, cmp.Comparer(proto.Equal)) {
+		t.Errorf("Equivocations(): %v, want %v", got, want)
+	}
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/google/keytransparency/core/crypto/vrf/p256"
 	"github.com/google/keytransparency/core/directory"
 	"github.com/google/keytransparency/core/mutator"
+	"github.com/google/keytransparency/core/mutator/entry"
 	"github.com/google/keytransparency/core/water"
 
 	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
@@ -96,7 +97,6 @@ type NewFromWrappedKeyFunc func(context.Context, proto.Message) (vrf.PrivateKey,
 type Server struct {
 	tlog              tpb.TrillianLogClient
 	tmap              tpb.TrillianMapClient
-	verifyMutation    mutator.VerifyMutationFn
 	directories       directory.Storage
 	logs              MutationLogs
 	batches           BatchReader
@@ -108,7 +108,6 @@ type Server struct {
 // revisionPageSize sets the maximum number of map revision to return per list API.
 func New(tlog tpb.TrillianLogClient,
 	tmap tpb.TrillianMapClient,
-	verifyMutation mutator.VerifyMutationFn,
 	directories directory.Storage,
 	logs MutationLogs,
 	batches BatchReader,
@@ -119,7 +118,6 @@ func New(tlog tpb.TrillianLogClient,
 	return &Server{
 		tlog:              tlog,
 		tmap:              tmap,
-		verifyMutation:    verifyMutation,
 		directories:       directories,
 		logs:              logs,
 		batches:           batches,
@@ -238,8 +236,13 @@ func (s *Server) batchGetUserByRevision(ctx context.Context, sth *tpb.SignedLogR
 		if mapLeafInclusion.Leaf == nil {
 			return nil, status.Errorf(codes.Internal, "leaf is nil")
 		}
+		signed, err := entry.FromLeafValue(mapLeafInclusion.Leaf.LeafValue)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Cannot read leaf value")
+		}
 		var committed *pb.Committed
-		if mapLeafInclusion.Leaf.LeafValue != nil {
+		// Tombstones do not have committed data.
+		if signed != nil && !entry.IsDeleted(signed) {
 			extraData := mapLeafInclusion.Leaf.ExtraData
 			if extraData == nil {
 				return nil, status.Errorf(codes.Internal, "Missing commitment data")
@@ -632,6 +635,14 @@ func (s *Server) BatchQueueUserUpdate(ctx context.Context, in *pb.BatchQueueUser
 	if err != nil {
 		return nil, err
 	}
+	mut, err := entry.GetMutator(directory.Mutator, entry.Options{
+		AllowReregistration: directory.AllowReregistration,
+		AdminKeyset:         directory.AdminKeyset,
+	})
+	if err != nil {
+		glog.Errorf("GetMutator(%v): %v", in.DirectoryId, err)
+		return nil, status.Errorf(codes.Internal, "Cannot fetch directory mutator")
+	}
 
 	// Verify:
 	// - Index to Key equality in SignedKV.
@@ -642,7 +653,11 @@ func (s *Server) BatchQueueUserUpdate(ctx context.Context, in *pb.BatchQueueUser
 	for _, u := range in.Updates {
 		u := u // https://golang.org/doc/faq#closures_and_goroutines
 		g.Go(func() error {
-			if err := s.verifyMutation(u.Mutation); err != nil {
+			if entry.IsAdminOverride(u.Mutation) {
+				glog.Warningf("Invalid UpdateEntryRequest: admin override")
+				return status.Errorf(codes.PermissionDenied, "Admin overrides must be queued through the admin API")
+			}
+			if err := mut.VerifyMutation(u.Mutation); err != nil {
 				glog.Warningf("Invalid UpdateEntryRequest: %v", err)
 				return status.Errorf(codes.InvalidArgument, "Invalid mutation")
 			}
@@ -704,12 +719,15 @@ func (s *Server) GetDirectory(ctx context.Context, in *pb.GetDirectoryRequest) (
 	}
 
 	return &pb.Directory{
-		DirectoryId: directory.DirectoryID,
-		Log:         directory.Log,
-		Map:         directory.Map,
-		Vrf:         directory.VRF,
-		MinInterval: ptypes.DurationProto(directory.MinInterval),
-		MaxInterval: ptypes.DurationProto(directory.MaxInterval),
+		DirectoryId:         directory.DirectoryID,
+		Log:                 directory.Log,
+		Map:                 directory.Map,
+		Vrf:                 directory.VRF,
+		MinInterval:         ptypes.DurationProto(directory.MinInterval),
+		MaxInterval:         ptypes.DurationProto(directory.MaxInterval),
+		Mutator:             directory.Mutator,
+		AllowReregistration: directory.AllowReregistration,
+		AdminKeyset:         directory.AdminKeyset,
 	}, nil
 }
 
@@ -16,6 +16,7 @@ package keyserver
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
@@ -29,6 +30,7 @@ import (
 	"github.com/google/keytransparency/core/water"
 
 	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	rtpb "github.com/google/keytransparency/core/keyserver/readtoken_go_proto"
 	tpb "github.com/google/trillian"
 )
 
@@ -37,6 +39,9 @@ var (
 	defaultPageSize = int32(16) //32KB
 	// Maximum allowed requested page size to prevent DOS.
 	maxPageSize = int32(2048) // 8MB
+	// How often GetRevisionStream checks for new revisions when the directory
+	// does not specify a MinInterval.
+	defaultRevisionPollPeriod = 1 * time.Second
 )
 
 // GetLatestRevision returns the latest revision. The current revision tracks the SignedLogRoot.
@@ -114,9 +119,67 @@ func (s *Server) getRevisionByRevision(ctx context.Context, d *directory.Directo
 	}, nil
 }
 
-// GetRevisionStream is a streaming API similar to ListMutations.
-func (*Server) GetRevisionStream(in *pb.GetRevisionRequest, stream pb.KeyTransparency_GetRevisionStreamServer) error {
-	return status.Error(codes.Unimplemented, "GetRevisionStream is unimplemented")
+// GetRevisionStream sends every published revision starting at in.Revision,
+// and then continues to send new revisions as they are published.
+//
+// The first revision sent carries a log consistency proof from
+// in.LastVerified. Each following revision carries a log consistency proof
+// from the latest_log_root of the revision sent before it.
+func (s *Server) GetRevisionStream(in *pb.GetRevisionRequest, stream pb.KeyTransparency_GetRevisionStreamServer) error {
+	ctx := stream.Context()
+	if err := validateGetRevisionRequest(in); err != nil {
+		glog.Errorf("validateGetRevisionRequest(%v): %v", in, err)
+		return status.Error(codes.InvalidArgument, "Invalid request")
+	}
+
+	// Lookup log and map info.
+	d, err := s.directories.Read(ctx, in.DirectoryId, false)
+	if st := status.Convert(err); st.Code() != codes.OK {
+		glog.Errorf("GetRevisionStream(): adminstorage.Read(%v): %v", in.DirectoryId, err)
+		return status.Errorf(st.Code(), "Cannot fetch directory info: %v", st.Message())
+	}
+
+	// New revisions are not created more often than MinInterval.
+	pollPeriod := d.MinInterval
+	if pollPeriod <= 0 {
+		pollPeriod = defaultRevisionPollPeriod
+	}
+	ticker := time.NewTicker(pollPeriod)
+	defer ticker.Stop()
+
+	treeSize := in.GetLastVerified().GetTreeSize()
+	for next := in.GetRevision(); ; {
+		logRoot, logConsistency, err := s.latestLogRootProof(ctx, d, treeSize)
+		if err != nil {
+			return err
+		}
+		var root types.LogRootV1
+		if err := root.UnmarshalBinary(logRoot.GetLogRoot()); err != nil {
+			return status.Errorf(codes.Internal, "keyserver: Failed to unmarshal log root: %v", err)
+		}
+
+		// Send all the revisions that are included in the latest log root.
+		for ; next < int64(root.TreeSize); next++ {
+			rev, err := s.getRevisionByRevision(ctx, d, logRoot, logConsistency, next)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(rev); err != nil {
+				return err
+			}
+			// The client has now seen logRoot.
+			// Later revisions under the same log root need no consistency proof.
+			treeSize = int64(root.TreeSize)
+			logConsistency = nil
+		}
+
+		// Wait for the log to grow.
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
 }
 
 // ListMutations returns the mutations that created an revision.
@@ -139,22 +202,90 @@ func (s *Server) ListMutations(ctx context.Context, in *pb.ListMutationsRequest)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Failed parsing page_token: %v: %v", in.PageToken, err)
 	}
+	if len(meta.Sources) == 0 {
+		return &pb.ListMutationsResponse{}, nil
+	}
+
+	mutations, next, err := s.readMutations(ctx, d, meta.Sources, rt, in.Revision, in.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	nextToken, err := EncodeToken(next)
+	if st := status.Convert(err); st.Code() != codes.OK {
+		return nil, status.Errorf(st.Code(), "Failed creating next token: %v", st.Message())
+	}
+	return &pb.ListMutationsResponse{
+		Mutations:     mutations,
+		NextPageToken: nextToken,
+	}, nil
+}
 
+// ListMutationsStream streams all the mutations that created a revision.
+func (s *Server) ListMutationsStream(in *pb.ListMutationsRequest, stream pb.KeyTransparency_ListMutationsStreamServer) error {
+	ctx := stream.Context()
+	if err := validateListMutationsRequest(in); err != nil {
+		glog.Errorf("validateListMutationsRequest(%v): %v", in, err)
+		return status.Error(codes.InvalidArgument, "Invalid request")
+	}
+	if in.PageToken != "" {
+		return status.Error(codes.InvalidArgument, "page_token is not supported by ListMutationsStream")
+	}
+	// Lookup log and map info.
+	d, err := s.directories.Read(ctx, in.DirectoryId, false)
+	if st := status.Convert(err); st.Code() != codes.OK {
+		glog.Errorf("ListMutationsStream(): adminstorage.Read(%v): %v", in.DirectoryId, err)
+		return status.Errorf(st.Code(), "Cannot fetch directory info: %v", st.Message())
+	}
+	meta, err := s.batches.ReadBatch(ctx, in.DirectoryId, in.Revision)
+	if st := status.Convert(err); st.Code() != codes.OK {
+		return status.Errorf(st.Code(), "ReadBatch(%v, %v): %v", in.DirectoryId, in.Revision, st.Message())
+	}
+	sources := SourceList(meta.Sources)
+	if len(sources) == 0 {
+		return nil
+	}
+
+	// Read in.PageSize mutations at a time until all source slices are exhausted.
+	for rt := sources.First(); ; {
+		mutations, next, err := s.readMutations(ctx, d, sources, rt, in.Revision, in.PageSize)
+		if err != nil {
+			return err
+		}
+		for _, m := range mutations {
+			if err := stream.Send(m); err != nil {
+				return err
+			}
+		}
+		if proto.Equal(next, &rtpb.ReadToken{}) {
+			return nil
+		}
+		rt = next
+	}
+}
+
+// readMutations reads up to pageSize mutations starting at rt and attaches the
+// leaf values from the previous map revision. readMutations also returns the
+// read token for the next page, which is empty when there are no more pages.
+func (s *Server) readMutations(ctx context.Context, d *directory.Directory, sources SourceList,
+	rt *rtpb.ReadToken, revision int64, pageSize int32) ([]*pb.MutationProof, *rtpb.ReadToken, error) {
+	if rt.SliceIndex < 0 || rt.SliceIndex >= int64(len(sources)) {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "Invalid slice index: %v", rt.SliceIndex)
+	}
 	// Read PageSize + 1 messages from the log to see if there is another page.
-	high := metadata.FromProto(meta.Sources[rt.SliceIndex]).HighMark()
-	logID := meta.Sources[rt.SliceIndex].LogId
+	high := metadata.FromProto(sources[rt.SliceIndex]).HighMark()
+	logID := sources[rt.SliceIndex].LogId
 	low := water.NewMark(rt.StartWatermark)
-	msgs, err := s.logs.ReadLog(ctx, d.DirectoryID, logID, low, high, in.PageSize+1)
+	msgs, err := s.logs.ReadLog(ctx, d.DirectoryID, logID, low, high, pageSize+1)
 	if st := status.Convert(err); st.Code() != codes.OK {
 		glog.Errorf("ListMutations(): ReadLog(%v, log: %v/(%v, %v], batchSize: %v): %v",
-			d.DirectoryID, logID, low, high, in.PageSize, err)
-		return nil, status.Errorf(st.Code(), "Reading mutations range failed: %v", st.Message())
+			d.DirectoryID, logID, low, high, pageSize, err)
+		return nil, nil, status.Errorf(st.Code(), "Reading mutations range failed: %v", st.Message())
 	}
-	moreInLogID := len(msgs) == int(in.PageSize+1)
+	moreInLogID := len(msgs) == int(pageSize+1)
 	var lastRow *mutator.LogMessage
 	if moreInLogID {
-		lastRow = msgs[in.PageSize] // Next start is the last row of this batch.
-		msgs = msgs[0:in.PageSize]  // Only return PageSize messages.
+		lastRow = msgs[pageSize] // Next start is the last row of this batch.
+		msgs = msgs[0:pageSize]  // Only return PageSize messages.
 	}
 
 	// For each msg, attach the leaf value from the previous map revision.
@@ -162,33 +293,24 @@ func (s *Server) ListMutations(ctx context.Context, in *pb.ListMutationsRequest)
 	indexes := make([][]byte, 0, len(msgs))
 	mutations := make([]*pb.MutationProof, 0, len(msgs))
 	for _, m := range msgs {
-		mutations = append(mutations, &pb.MutationProof{Mutation: m.Mutation})
 		var entry pb.Entry
 		if err := proto.Unmarshal(m.Mutation.Entry, &entry); err != nil {
-			return nil, status.Errorf(codes.DataLoss, "could not unmarshal entry")
+			return nil, nil, status.Errorf(codes.DataLoss, "could not unmarshal entry")
 		}
+		mutations = append(mutations, &pb.MutationProof{
+			Mutation:      m.Mutation,
+			AdminOverride: entry.GetAdminOverride(),
+		})
 		indexes = append(indexes, entry.GetIndex())
 	}
-	proofs, err := s.inclusionProofs(ctx, d, indexes, in.Revision-1)
+	proofs, err := s.inclusionProofs(ctx, d, indexes, revision-1)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	for i, p := range proofs {
 		mutations[i].LeafProof = p
 	}
-	nextToken, err := EncodeToken(SourceList(meta.Sources).Next(rt, lastRow))
-	if st := status.Convert(err); st.Code() != codes.OK {
-		return nil, status.Errorf(st.Code(), "Failed creating next token: %v", st.Message())
-	}
-	return &pb.ListMutationsResponse{
-		Mutations:     mutations,
-		NextPageToken: nextToken,
-	}, nil
-}
-
-// ListMutationsStream is a streaming list of mutations in a specific revision.
-func (*Server) ListMutationsStream(in *pb.ListMutationsRequest, stream pb.KeyTransparency_ListMutationsStreamServer) error {
-	return status.Error(codes.Unimplemented, "ListMutationStream is unimplemented")
+	return mutations, sources.Next(rt, lastRow), nil
 }
 
 // logInclusion returns the inclusion proof for a map revision in the log of map roots.
@@ -23,10 +23,13 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/keytransparency/core/directory"
 	"github.com/google/keytransparency/core/sequencer/metadata"
 	"github.com/google/keytransparency/core/water"
 	"github.com/google/keytransparency/impl/memory"
 	"github.com/google/trillian/testonly/matchers"
+	"github.com/google/trillian/types"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -70,11 +73,89 @@ func genEntryUpdates(t *testing.T, start, end int64) []*pb.EntryUpdate {
 	return entries
 }
 
+type fakeRevisionStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	send func(*pb.Revision) error
+}
+
+func (s *fakeRevisionStream) Context() context.Context { return s.ctx }
+
+func (s *fakeRevisionStream) Send(r *pb.Revision) error { return s.send(r) }
+
 func TestGetRevisionStream(t *testing.T) {
-	srv := &Server{}
-	err := srv.GetRevisionStream(nil, nil)
-	if got, want := status.Code(err), codes.Unimplemented; got != want {
-		t.Errorf("GetMutationsStream(_, _): %v, want %v", got, want)
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	e, err := newMiniEnv(ctx, t)
+	if err != nil {
+		t.Fatalf("newMiniEnv(): %v", err)
+	}
+	defer e.Close()
+	dirID := "TestGetRevisionStream"
+	if err := e.srv.directories.Write(ctx, &directory.Directory{
+		DirectoryID: dirID,
+		Map:         &tpb.Tree{TreeId: mapID},
+		Log:         &tpb.Tree{},
+		MinInterval: 10 * time.Millisecond,
+	}); err != nil {
+		t.Fatalf("directories.Write(): %v", err)
+	}
+
+	// The log grows from 2 to 4 revisions between the first and second poll.
+	e.s.Log.EXPECT().GetLatestSignedLogRoot(gomock.Any(), matchers.ProtoEqual(
+		&tpb.GetLatestSignedLogRootRequest{FirstTreeSize: 1})).
+		Return(&tpb.GetLatestSignedLogRootResponse{
+			SignedLogRoot: mustMarshalRoot(t, &types.LogRootV1{TreeSize: 2}),
+			Proof:         &tpb.Proof{Hashes: [][]byte{[]byte("1to2")}},
+		}, nil)
+	e.s.Log.EXPECT().GetLatestSignedLogRoot(gomock.Any(), matchers.ProtoEqual(
+		&tpb.GetLatestSignedLogRootRequest{FirstTreeSize: 2})).
+		Return(&tpb.GetLatestSignedLogRootResponse{
+			SignedLogRoot: mustMarshalRoot(t, &types.LogRootV1{TreeSize: 4}),
+			Proof:         &tpb.Proof{Hashes: [][]byte{[]byte("2to4")}},
+		}, nil)
+	e.s.Log.EXPECT().GetLatestSignedLogRoot(gomock.Any(), matchers.ProtoEqual(
+		&tpb.GetLatestSignedLogRootRequest{FirstTreeSize: 4})).
+		Return(&tpb.GetLatestSignedLogRootResponse{
+			SignedLogRoot: mustMarshalRoot(t, &types.LogRootV1{TreeSize: 4}),
+		}, nil).AnyTimes()
+	e.s.Log.EXPECT().GetInclusionProof(gomock.Any(), gomock.Any()).
+		Return(&tpb.GetInclusionProofResponse{}, nil).AnyTimes()
+	for rev := int64(1); rev < 4; rev++ {
+		e.s.Map.EXPECT().GetSignedMapRootByRevision(gomock.Any(), matchers.ProtoEqual(
+			&tpb.GetSignedMapRootByRevisionRequest{MapId: mapID, Revision: rev})).
+			Return(&tpb.GetSignedMapRootResponse{
+				MapRoot: &tpb.SignedMapRoot{MapRoot: []byte{byte(rev)}},
+			}, nil)
+	}
+
+	type result struct {
+		revision    byte
+		consistency int
+	}
+	want := []result{{1, 1}, {2, 1}, {3, 0}}
+	got := []result{}
+	sctx, scancel := context.WithCancel(ctx)
+	defer scancel()
+	err = e.srv.GetRevisionStream(&pb.GetRevisionRequest{
+		DirectoryId:  dirID,
+		Revision:     1,
+		LastVerified: &pb.LogRootRequest{TreeSize: 1},
+	}, &fakeRevisionStream{ctx: sctx, send: func(r *pb.Revision) error {
+		got = append(got, result{
+			revision:    r.GetMapRoot().GetMapRoot().GetMapRoot()[0],
+			consistency: len(r.GetLatestLogRoot().GetLogConsistency()),
+		})
+		if len(got) == len(want) {
+			scancel()
+		}
+		return nil
+	}})
+	if err != context.Canceled {
+		t.Errorf("GetRevisionStream(): %v, want %v", err, context.Canceled)
+	}
+	if !cmp.Equal(got, want, cmp.AllowUnexported(result{})) {
+		t.Errorf("GetRevisionStream() sent %v, want %v", got, want)
 	}
 }
 
@@ -104,13 +185,12 @@ func MustEncodeToken(t *testing.T, low water.Mark) string {
 
 func TestListMutations(t *testing.T) {
 	ctx := context.Background()
-	dirID := "TestListMutations"
 	logID := int64(0)
 	fakeLogs := memory.NewMutationLogs()
 	idx := make([]water.Mark, 0, 12)
 	for i := int64(0); i < 12; i++ {
 		// Send one entry.
-		ws, err := fakeLogs.SendBatch(ctx, dirID, logID, genEntryUpdates(t, i, i+1))
+		ws, err := fakeLogs.SendBatch(ctx, directoryID, logID, genEntryUpdates(t, i, i+1))
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -147,7 +227,7 @@ func TestListMutations(t *testing.T) {
 				t.Fatalf("newMiniEnv(): %v", err)
 			}
 			defer e.Close()
-			e.srv.logs = &fakeLogs
+			e.srv.logs = fakeLogs
 			e.srv.batches = fakeBatches
 
 			if !tc.wantErr {
@@ -194,3 +274,102 @@ func TestListMutations(t *testing.T) {
 		})
 	}
 }
+
+type fakeMutationStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	mutations []*pb.MutationProof
+}
+
+func (s *fakeMutationStream) Context() context.Context { return s.ctx }
+
+func (s *fakeMutationStream) Send(m *pb.MutationProof) error {
+	s.mutations = append(s.mutations, m)
+	return nil
+}
+
+func TestListMutationsStream(t *testing.T) {
+	ctx := context.Background()
+	fakeLogs := memory.NewMutationLogs()
+	idx := make(map[int64][]water.Mark)
+	for i := int64(0); i < 12; i++ {
+		// Send one entry to each log, alternating between logs 0 and 1.
+		logID := i % 2
+		ws, err := fakeLogs.SendBatch(ctx, directoryID, logID, genEntryUpdates(t, i, i+1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		idx[logID] = append(idx[logID], ws)
+	}
+
+	fakeBatches := batchStorage{
+		1: SourceList{},
+		2: SourceList{newSource(0, idx[0][0], idx[0][3]), newSource(1, idx[1][1], idx[1][5])},
+	}
+	// Log 0 contains even keys and log 1 contains odd keys.
+	allMutations := []*pb.EntryUpdate{}
+	for _, i := range []int64{0, 2, 4, 3, 5, 7, 9} {
+		allMutations = append(allMutations, genEntryUpdates(t, i, i+1)...)
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		revision int64
+		pageSize int32
+		token    string
+		want     []*pb.EntryUpdate
+		wantCode codes.Code
+	}{
+		{desc: "empty", revision: 1},
+		{desc: "one page", revision: 2, pageSize: 10, want: allMutations},
+		{desc: "many pages", revision: 2, pageSize: 1, want: allMutations},
+		{desc: "page token", revision: 2, token: MustEncodeToken(t, idx[0][1]), wantCode: codes.InvalidArgument},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(ctx, 500*time.Millisecond)
+			defer cancel()
+			e, err := newMiniEnv(ctx, t)
+			if err != nil {
+				t.Fatalf("newMiniEnv(): %v", err)
+			}
+			defer e.Close()
+			e.srv.logs = fakeLogs
+			e.srv.batches = fakeBatches
+			e.s.Map.EXPECT().GetLeavesByRevision(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, req *tpb.GetMapLeavesByRevisionRequest) (*tpb.GetMapLeavesResponse, error) {
+					if got, want := req.Revision, tc.revision-1; got != want {
+						t.Errorf("GetLeavesByRevision(): revision %v, want %v", got, want)
+					}
+					return &tpb.GetMapLeavesResponse{
+						MapLeafInclusion: genInclusions(0, int64(len(req.Index))),
+					}, nil
+				}).AnyTimes()
+
+			stream := &fakeMutationStream{ctx: ctx}
+			err = e.srv.ListMutationsStream(&pb.ListMutationsRequest{
+				DirectoryId: directoryID,
+				Revision:    tc.revision,
+				PageSize:    tc.pageSize,
+				PageToken:   tc.token,
+			}, stream)
+			if got, want := status.Code(err), tc.wantCode; got != want {
+				t.Fatalf("ListMutationsStream(): %v, want %v", err, want)
+			}
+
+			got := []*pb.EntryUpdate{}
+			for _, m := range stream.mutations {
+				if m.LeafProof == nil {
+					t.Errorf("ListMutationsStream(): missing leaf proof")
+				}
+				got = append(got, &pb.EntryUpdate{Mutation: m.Mutation})
+			}
+			want := tc.want
+			if want == nil {
+				want = []*pb.EntryUpdate{}
+			}
+			if !cmp.Equal(got, want, cmp.Comparer(proto.Equal)) {
+				t.Errorf("got: %v, want: %v, diff: \n%v", got, want, cmp.Diff(got, want, cmp.Comparer(proto.Equal)))
+			}
+		})
+	}
+}
@@ -39,6 +39,8 @@ const (
 var (
 	// ErrNoCommitted occurs when the committed field is missing.
 	ErrNoCommitted = errors.New("missing commitment")
+	// ErrTombstoneCommitted occurs when a tombstone has committed data.
+	ErrTombstoneCommitted = errors.New("tombstone has committed data")
 	// ErrCommittedKeyLen occurs when the committed key is too small.
 	ErrCommittedKeyLen = errors.New("committed.key is too small")
 	// ErrWrongIndex occurs when the index in key value does not match the
@@ -56,6 +58,7 @@ var (
 
 // validateEntryUpdate verifies
 // - Commitment in SignedEntryUpdate matches the serialized profile.
+// - Tombstones have no profile.
 func validateEntryUpdate(in *pb.EntryUpdate, vrfPriv vrf.PrivateKey) error {
 	var entry pb.Entry
 	if err := proto.Unmarshal(in.GetMutation().GetEntry(), &entry); err != nil {
@@ -68,6 +71,14 @@ func validateEntryUpdate(in *pb.EntryUpdate, vrfPriv vrf.PrivateKey) error {
 		return ErrWrongIndex
 	}
 
+	// Tombstones do not commit to a profile.
+	if entry.Deleted {
+		if in.GetCommitted() != nil {
+			return ErrTombstoneCommitted
+		}
+		return nil
+	}
+
 	// Verify correct commitment to profile.
 	committed := in.GetCommitted()
 	if committed == nil {
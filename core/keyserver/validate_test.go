@@ -78,3 +78,31 @@ func TestValidateUpdateEntryRequest(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateTombstone(t *testing.T) {
+	userID := "joe"
+	vrfPriv, _ := p256.GenerateKey()
+	index, _ := vrfPriv.Evaluate([]byte(userID))
+
+	for _, tc := range []struct {
+		committed *pb.Committed
+		want      error
+	}{
+		{committed: nil},
+		{committed: &pb.Committed{Data: []byte("bar")}, want: ErrTombstoneCommitted},
+	} {
+		req := &pb.EntryUpdate{
+			UserId: userID,
+			Mutation: &pb.SignedEntry{
+				Entry: mustMarshal(t, &pb.Entry{
+					Index:   index[:],
+					Deleted: true,
+				}),
+			},
+			Committed: tc.committed,
+		}
+		if err := validateEntryUpdate(req, vrfPriv); err != tc.want {
+			t.Errorf("validateEntryUpdate(%v): %v, want %v", req, err, tc.want)
+		}
+	}
+}
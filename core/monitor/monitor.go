@@ -24,8 +24,9 @@ import (
 	"github.com/google/keytransparency/core/client/tracker"
 	"github.com/google/keytransparency/core/client/verifier"
 	"github.com/google/keytransparency/core/monitorstorage"
+	"github.com/google/keytransparency/core/mutator"
+	"github.com/google/keytransparency/core/mutator/entry"
 	"github.com/google/trillian"
-	"github.com/google/trillian/types"
 
 	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
 	tclient "github.com/google/trillian/client"
@@ -35,14 +36,17 @@ import (
 // Monitor holds the internal state for a monitor accessing the mutations API
 // and for verifying its responses.
 type Monitor struct {
+	ktURL       string
 	cli         *client.Client
 	mapVerifier *tclient.MapVerifier
 	signer      *tcrypto.Signer
 	store       monitorstorage.Interface
+	mutate      mutator.MutateFn
 }
 
 // NewFromDirectory produces a new monitor from a Directory object.
-func NewFromDirectory(cli pb.KeyTransparencyClient,
+// ktURL identifies the Key Transparency server that hosts the directory.
+func NewFromDirectory(ktURL string, cli pb.KeyTransparencyClient,
 	config *pb.Directory,
 	signer *tcrypto.Signer,
 	store monitorstorage.Interface) (*Monitor, error) {
@@ -50,6 +54,13 @@ func NewFromDirectory(cli pb.KeyTransparencyClient,
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize map verifier: %v", err)
 	}
+	mut, err := entry.GetMutator(config.GetMutator(), entry.Options{
+		AllowReregistration: config.GetAllowReregistration(),
+		AdminKeyset:         config.GetAdminKeyset(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize mutator: %v", err)
+	}
 
 	ktClient, err := client.NewFromConfig(cli, config,
 		func(lv *tclient.LogVerifier) verifier.LogTracker { return tracker.NewSynchronous(lv) },
@@ -58,31 +69,40 @@ func NewFromDirectory(cli pb.KeyTransparencyClient,
 		return nil, fmt.Errorf("could not create kt client: %v", err)
 	}
 
-	return New(ktClient, mapVerifier, signer, store)
+	m, err := New(ktURL, ktClient, mapVerifier, signer, store)
+	if err != nil {
+		return nil, err
+	}
+	m.mutate = mut.Mutate
+	return m, nil
 }
 
-// New creates a new instance of the monitor.
-func New(cli *client.Client,
+// New creates a new instance of the monitor that verifies mutations with the
+// default mutator.
+// Results are stored in store under ktURL and the client's directory.
+func New(ktURL string, cli *client.Client,
 	mapVerifier *tclient.MapVerifier,
 	signer *tcrypto.Signer,
 	store monitorstorage.Interface) (*Monitor, error) {
 	return &Monitor{
+		ktURL:       ktURL,
 		cli:         cli,
 		mapVerifier: mapVerifier,
 		signer:      signer,
 		store:       store,
+		mutate:      entry.MutateFn,
 	}, nil
 }
 
 // RevisionPair is two adjacent revisions.
 type RevisionPair struct {
-	A, B *types.MapRootV1
+	A, B *client.MapRevision
 }
 
 // RevisionPairs consumes revisions (0, 1, 2) and produces pairs (0,1), (1,2).
-func RevisionPairs(ctx context.Context, revisions <-chan *types.MapRootV1, pairs chan<- RevisionPair) error {
+func RevisionPairs(ctx context.Context, revisions <-chan *client.MapRevision, pairs chan<- RevisionPair) error {
 	defer close(pairs)
-	var revisionA *types.MapRootV1
+	var revisionA *client.MapRevision
 	for revision := range revisions {
 		if revisionA == nil {
 			revisionA = revision
@@ -106,7 +126,7 @@ func RevisionPairs(ctx context.Context, revisions <-chan *types.MapRootV1, pairs
 func (m *Monitor) ProcessLoop(ctx context.Context, startRev int64) error {
 	cctx, cancel := context.WithCancel(ctx)
 	errc := make(chan error)
-	revisions := make(chan *types.MapRootV1)
+	revisions := make(chan *client.MapRevision)
 	pairs := make(chan RevisionPair)
 
 	go func(ctx context.Context) {
@@ -122,7 +142,7 @@ func (m *Monitor) ProcessLoop(ctx context.Context, startRev int64) error {
 	defer cancel()
 
 	for pair := range pairs {
-		mutations, err := m.cli.RevisionMutations(ctx, pair.B)
+		mutations, err := m.cli.RevisionMutations(ctx, pair.B.MapRootV1)
 		if err != nil {
 			return err
 		}
@@ -130,22 +150,23 @@ func (m *Monitor) ProcessLoop(ctx context.Context, startRev int64) error {
 		var smr *trillian.SignedMapRoot
 		var errList []error
 
-		if errs := m.verifyMutations(mutations, pair.A, pair.B); len(errs) > 0 {
+		if errs := m.verifyMutations(mutations, pair.A.MapRootV1, pair.B.MapRootV1); len(errs) > 0 {
 			glog.Errorf("Invalid Revision %v Mutations: %v", pair.B.Revision, errs)
 			errList = errs
 		} else {
 			// Sign if successful.
-			smr, err = m.signer.SignMapRoot(pair.B)
+			smr, err = m.signer.SignMapRoot(pair.B.MapRootV1)
 			if err != nil {
 				return err
 			}
 		}
 
 		// Save result.
-		if err := m.store.Set(int64(pair.B.Revision), &monitorstorage.Result{
-			Smr:    smr,
-			Seen:   time.Now(),
-			Errors: errList,
+		if err := m.store.Set(ctx, m.ktURL, m.cli.DirectoryID, int64(pair.B.Revision), &monitorstorage.Result{
+			Smr:       smr,
+			ServerSmr: pair.B.Signed,
+			Seen:      time.Now(),
+			Errors:    errList,
 		}); err != nil {
 			return fmt.Errorf("monitorstorage.Set(%v, _): %v", pair.B.Revision, err)
 		}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/keytransparency/core/client"
 	"github.com/google/trillian/types"
 )
 
@@ -31,10 +32,10 @@ func TestRevisionPairs(t *testing.T) {
 	}{
 		{in: []byte{0, 1, 2}, out: []struct{ a, b byte }{{0, 1}, {1, 2}}},
 	} {
-		revisions := make(chan *types.MapRootV1, len(tc.in)+1)
+		revisions := make(chan *client.MapRevision, len(tc.in)+1)
 		pairs := make(chan RevisionPair, len(tc.out)+1)
 		for _, i := range tc.in {
-			revisions <- &types.MapRootV1{RootHash: []byte{i}}
+			revisions <- &client.MapRevision{MapRootV1: &types.MapRootV1{RootHash: []byte{i}}}
 		}
 		close(revisions)
 		if err := RevisionPairs(ctx, revisions, pairs); err != nil {
@@ -23,6 +23,7 @@ import (
 	"math/big"
 
 	"github.com/golang/glog"
+	"github.com/google/keytransparency/core/monitorstorage"
 	"github.com/google/keytransparency/core/mutator/entry"
 	"github.com/google/trillian/merkle"
 	"github.com/google/trillian/storage"
@@ -77,15 +78,7 @@ func (e *ErrList) appendErr(err ...error) {
 // Proto converts all the errors to statuspb.Status.
 // If the original error was not a status.Status, we use codes.Unknown.
 func (e *ErrList) Proto() []*statuspb.Status {
-	errs := make([]*statuspb.Status, 0, len(*e))
-	for _, err := range *e {
-		if s, ok := status.FromError(err); ok {
-			errs = append(errs, s.Proto())
-			continue
-		}
-		errs = append(errs, status.Newf(codes.Unknown, "%v", err).Proto())
-	}
-	return errs
+	return monitorstorage.StatusProtos(*e)
 }
 
 func (m *Monitor) verifyMutations(muts []*pb.MutationProof, oldRoot, expectedNewRoot *types.MapRootV1) []error {
@@ -107,8 +100,15 @@ func (m *Monitor) verifyMutations(muts []*pb.MutationProof, oldRoot, expectedNew
 			errs.AppendStatus(status.Newf(codes.DataLoss, "invalid  map inclusion proof: %v", err).WithDetails(mut.GetLeafProof()))
 		}
 
+		// Administrative overrides must be reported as such.
+		if override := entry.IsAdminOverride(mut.GetMutation()); override != mut.GetAdminOverride() {
+			errs.AppendStatus(status.Newf(codes.DataLoss, "admin override tagged %v, want %v", mut.GetAdminOverride(), override).WithDetails(mut.GetMutation()))
+		} else if override {
+			glog.Infof("Admin override of index %x", index)
+		}
+
 		// compute the new leaf
-		newValue, err := entry.MutateFn(oldLeaf, mut.GetMutation())
+		newValue, err := m.mutate(oldLeaf, mut.GetMutation(), int64(expectedNewRoot.Revision))
 		if err != nil {
 			glog.Infof("Mutation did not verify: %v", err)
 			errs.AppendStatus(status.Newf(codes.DataLoss, "invalid mutation: %v", err).WithDetails(mut.GetMutation()))
@@ -20,9 +20,25 @@ package monitorserver
 
 import (
 	"context"
+	"crypto"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
 	"github.com/google/keytransparency/core/fake"
+	"github.com/google/keytransparency/core/monitorstorage"
+	"github.com/google/trillian"
+	"github.com/google/trillian/crypto/keys"
+	"github.com/google/trillian/crypto/keys/der"
+	"github.com/google/trillian/crypto/keyspb"
+	"github.com/google/trillian/crypto/sigpb"
+	"github.com/google/trillian/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/google/keytransparency/core/api/monitor/v1/monitor_go_proto"
+	ktpb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	tcrypto "github.com/google/trillian/crypto"
 )
 
 func TestGetSignedMapRoot(t *testing.T) {
@@ -33,3 +49,105 @@ func TestGetSignedMapRoot(t *testing.T) {
 		t.Errorf("GetSignedMapRoot(_, _): %v, want %v", got, want)
 	}
 }
+
+func TestGossip(t *testing.T) {
+	ctx := context.Background()
+	const ktURL = "kt.test"
+	const directoryID = "dir"
+
+	key, err := keys.NewFromSpec(&keyspb.Specification{
+		Params: &keyspb.Specification_EcdsaParams{EcdsaParams: &keyspb.Specification_ECDSA{}},
+	})
+	if err != nil {
+		t.Fatalf("NewFromSpec(): %v", err)
+	}
+	pubKey, err := der.ToPublicProto(key.Public())
+	if err != nil {
+		t.Fatalf("ToPublicProto(): %v", err)
+	}
+	signer := tcrypto.NewSigner(0, key, crypto.SHA256)
+	sign := func(revision uint64, rootHash string) *trillian.SignedMapRoot {
+		t.Helper()
+		smr, err := signer.SignMapRoot(&types.MapRootV1{Revision: revision, RootHash: []byte(rootHash)})
+		if err != nil {
+			t.Fatalf("SignMapRoot(): %v", err)
+		}
+		return smr
+	}
+	observed := sign(1, "observed")
+	forged := sign(1, "forged")
+	forged.Signature = []byte("not a signature")
+
+	store := fake.NewMonitorStorage()
+	if err := store.Set(ctx, ktURL, directoryID, 1, &monitorstorage.Result{
+		ServerSmr: observed,
+		Seen:      time.Now(),
+	}); err != nil {
+		t.Fatalf("Set(): %v", err)
+	}
+	srv := New(store)
+	if err := srv.AddDirectory(ktURL, &ktpb.Directory{
+		DirectoryId: directoryID,
+		Map: &trillian.Tree{
+			TreeType:           trillian.TreeType_MAP,
+			HashStrategy:       trillian.HashStrategy_CONIKS_SHA256,
+			HashAlgorithm:      sigpb.DigitallySigned_SHA256,
+			SignatureAlgorithm: sigpb.DigitallySigned_ECDSA,
+			PublicKey:          pubKey,
+		},
+	}); err != nil {
+		t.Fatalf("AddDirectory(): %v", err)
+	}
+
+	for _, tc := range []struct {
+		desc             string
+		directoryID      string
+		smr              *trillian.SignedMapRoot
+		wantCode         codes.Code
+		wantEquivocation bool
+	}{
+		{desc: "unknown directory", directoryID: "unknown", smr: observed, wantCode: codes.NotFound},
+		{desc: "missing smr", directoryID: directoryID, wantCode: codes.InvalidArgument},
+		{desc: "bad signature", directoryID: directoryID, smr: forged, wantCode: codes.InvalidArgument},
+		{desc: "unprocessed revision", directoryID: directoryID, smr: sign(2, "observed"), wantCode: codes.NotFound},
+		{desc: "consistent", directoryID: directoryID, smr: sign(1, "observed")},
+		{desc: "equivocation", directoryID: directoryID, smr: sign(1, "split view"), wantEquivocation: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := srv.Gossip(ctx, &pb.GossipRequest{
+				KtUrl:       ktURL,
+				DirectoryId: tc.directoryID,
+				Smr:         tc.smr,
+			})
+			if got, want := status.Code(err), tc.wantCode; got != want {
+				t.Fatalf("Gossip(): %v, want %v", err, want)
+			}
+			if err != nil {
+				return
+			}
+			if got, want := resp.GetEquivocation() != nil, tc.wantEquivocation; got != want {
+				t.Fatalf("Gossip(): equivocation: %v, want %v", resp.GetEquivocation(), want)
+			}
+			if !tc.wantEquivocation {
+				return
+			}
+			want := &pb.Equivocation{
+				KtUrl:       ktURL,
+				DirectoryId: directoryID,
+				Revision:    1,
+				ObservedSmr: observed,
+				GossipedSmr: tc.smr,
+			}
+			if got := resp.GetEquivocation(); !proto.Equal(got, want) {
+				t.Errorf("Gossip(): %v, want %v", got, want)
+			}
+			stored, err := store.Equivocations(ctx, ktURL, directoryID)
+			if err != nil {
+				t.Fatalf("Equivocations(): %v", err)
+			}
+			if len(stored) != 1 || !proto.Equal(stored[0], want) {
+				t.Errorf("Equivocations(): %v, want [%v]", stored, want)
+			}
+		})
+	}
+}
@@ -22,17 +22,22 @@
 package monitorserver
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 
+	"github.com/golang/glog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	"github.com/golang/protobuf/ptypes"
-	"github.com/google/keytransparency/core/monitor"
 	"github.com/google/keytransparency/core/monitorstorage"
 
 	pb "github.com/google/keytransparency/core/api/monitor/v1/monitor_go_proto"
+	ktpb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	tclient "github.com/google/trillian/client"
+	_ "github.com/google/trillian/merkle/coniks" // Register hasher
 )
 
 var (
@@ -45,15 +50,38 @@ var (
 // responses via a grpc and HTTP API.
 type Server struct {
 	storage monitorstorage.Interface
+
+	mu           sync.RWMutex
+	mapVerifiers map[monitoredDirectory]*tclient.MapVerifier
+}
+
+// monitoredDirectory identifies a directory on a Key Transparency server.
+type monitoredDirectory struct {
+	ktURL       string
+	directoryID string
 }
 
 // New creates a new instance of the monitor server.
 func New(storage monitorstorage.Interface) *Server {
 	return &Server{
-		storage: storage,
+		storage:      storage,
+		mapVerifiers: make(map[monitoredDirectory]*tclient.MapVerifier),
 	}
 }
 
+// AddDirectory allows peers to gossip map roots for the directory described
+// by config, hosted by the Key Transparency server at ktURL.
+func (s *Server) AddDirectory(ktURL string, config *ktpb.Directory) error {
+	mapVerifier, err := tclient.NewMapVerifierFromTree(config.GetMap())
+	if err != nil {
+		return fmt.Errorf("could not initialize map verifier: %v", err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.mapVerifiers[monitoredDirectory{ktURL: ktURL, directoryID: config.GetDirectoryId()}] = mapVerifier
+	return nil
+}
+
 // GetState returns the latest valid signed map root the monitor
 // observed. Additionally, the response contains additional data necessary to
 // reproduce errors on failure.
@@ -63,11 +91,13 @@ func New(storage monitorstorage.Interface) *Server {
 // from the previous to the current revision it won't sign the map root and
 // additional data will be provided to reproduce the failure.
 func (s *Server) GetState(ctx context.Context, in *pb.GetStateRequest) (*pb.State, error) {
-	latestRevision := s.storage.LatestRevision()
-	if latestRevision == 0 {
+	latestRevision, err := s.storage.LatestRevision(ctx, in.GetKtUrl(), in.GetDirectoryId())
+	if err == monitorstorage.ErrNotFound {
 		return nil, ErrNothingProcessed
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not read latest revision: %v", err)
 	}
-	return s.getResponseByRevision(latestRevision)
+	return s.getResponseByRevision(ctx, in.GetKtUrl(), in.GetDirectoryId(), latestRevision)
 }
 
 // GetStateByRevision works similar to GetSignedMapRoot but returns
@@ -78,24 +108,67 @@ func (s *Server) GetState(ctx context.Context, in *pb.GetStateRequest) (*pb.Stat
 // mutations from the previous to the current revision it won't sign the map root
 // and additional data will be provided to reproduce the failure.
 func (s *Server) GetStateByRevision(ctx context.Context, in *pb.GetStateRequest) (*pb.State, error) {
-	return s.getResponseByRevision(in.GetRevision())
+	return s.getResponseByRevision(ctx, in.GetKtUrl(), in.GetDirectoryId(), in.GetRevision())
 }
 
-func (s *Server) getResponseByRevision(revision int64) (*pb.State, error) {
-	r, err := s.storage.Get(revision)
+func (s *Server) getResponseByRevision(ctx context.Context, ktURL, directoryID string, revision int64) (*pb.State, error) {
+	r, err := s.storage.Get(ctx, ktURL, directoryID, revision)
 	if err == monitorstorage.ErrNotFound {
 		return nil, status.Errorf(codes.NotFound, "Could not find monitoring response for revision %d", revision)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not read monitoring response: %v", err)
 	}
 
-	errs := monitor.ErrList(r.Errors)
-	seen, err := ptypes.TimestampProto(r.Seen)
+	state, err := r.Proto()
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "invalid timestamp: %v", err)
 	}
-	// Convert errors into rpc.Status
-	return &pb.State{
-		Smr:      r.Smr,
-		SeenTime: seen,
-		Errors:   errs.Proto(),
-	}, nil
+	return state, nil
+}
+
+// Gossip compares a map root observed by a peer with the map root the monitor
+// observed for the same revision. If the map roots differ, the monitor records
+// and returns an equivocation proof.
+func (s *Server) Gossip(ctx context.Context, in *pb.GossipRequest) (*pb.GossipResponse, error) {
+	if in.GetSmr() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "missing smr")
+	}
+	s.mu.RLock()
+	mapVerifier, ok := s.mapVerifiers[monitoredDirectory{ktURL: in.GetKtUrl(), directoryID: in.GetDirectoryId()}]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "directory %v/%v is not monitored", in.GetKtUrl(), in.GetDirectoryId())
+	}
+	// Only map roots signed by the server can prove that it equivocated.
+	mapRoot, err := mapVerifier.VerifySignedMapRoot(in.GetSmr())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid smr: %v", err)
+	}
+	revision := int64(mapRoot.Revision)
+
+	r, err := s.storage.Get(ctx, in.GetKtUrl(), in.GetDirectoryId(), revision)
+	if err == monitorstorage.ErrNotFound {
+		return nil, status.Errorf(codes.NotFound, "revision %d has not been processed yet", revision)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not read monitoring response: %v", err)
+	}
+	if r.ServerSmr == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no server signed map root stored for revision %d", revision)
+	}
+	if bytes.Equal(r.ServerSmr.GetMapRoot(), in.GetSmr().GetMapRoot()) {
+		return &pb.GossipResponse{}, nil
+	}
+
+	e := &pb.Equivocation{
+		KtUrl:       in.GetKtUrl(),
+		DirectoryId: in.GetDirectoryId(),
+		Revision:    revision,
+		ObservedSmr: r.ServerSmr,
+		GossipedSmr: in.GetSmr(),
+	}
+	glog.Errorf("Server %v equivocated on directory %v at revision %v", in.GetKtUrl(), in.GetDirectoryId(), revision)
+	if err := s.storage.AddEquivocation(ctx, e); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not record equivocation: %v", err)
+	}
+	return &pb.GossipResponse{Equivocation: e}, nil
 }
@@ -16,10 +16,17 @@
 package monitorstorage
 
 import (
+	"context"
 	"errors"
 	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"github.com/google/trillian"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mopb "github.com/google/keytransparency/core/api/monitor/v1/monitor_go_proto"
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
 )
 
 var (
@@ -44,6 +51,8 @@ type Result struct {
 	// Smr contains the map root signed by the monitor in case all verifications
 	// have passed.
 	Smr *trillian.SignedMapRoot
+	// ServerSmr contains the map root as signed by the key transparency server.
+	ServerSmr *trillian.SignedMapRoot
 	// Seen is the timestamp at which the mutations response has been received.
 	Seen time.Time
 	// Errors contains a string representation of the verifications steps that
@@ -51,13 +60,69 @@ type Result struct {
 	Errors []error
 }
 
+// Proto converts r into a State proto.
+// Errors that are not status errors are converted to codes.Unknown.
+func (r *Result) Proto() (*mopb.State, error) {
+	seen, err := ptypes.TimestampProto(r.Seen)
+	if err != nil {
+		return nil, err
+	}
+	return &mopb.State{
+		Smr:       r.Smr,
+		ServerSmr: r.ServerSmr,
+		SeenTime:  seen,
+		Errors:    StatusProtos(r.Errors),
+	}, nil
+}
+
+// StatusProtos converts errs to statuspb.Status.
+// Errors that are not status errors are converted to codes.Unknown.
+func StatusProtos(errs []error) []*statuspb.Status {
+	statuses := make([]*statuspb.Status, 0, len(errs))
+	for _, err := range errs {
+		if s, ok := status.FromError(err); ok {
+			statuses = append(statuses, s.Proto())
+			continue
+		}
+		statuses = append(statuses, status.Newf(codes.Unknown, "%v", err).Proto())
+	}
+	return statuses
+}
+
+// FromProto converts a State proto into a Result.
+func FromProto(s *mopb.State) (*Result, error) {
+	seen, err := ptypes.Timestamp(s.GetSeenTime())
+	if err != nil {
+		return nil, err
+	}
+	var errs []error
+	for _, e := range s.GetErrors() {
+		errs = append(errs, status.ErrorProto(e))
+	}
+	return &Result{
+		Smr:       s.GetSmr(),
+		ServerSmr: s.GetServerSmr(),
+		Seen:      seen,
+		Errors:    errs,
+	}, nil
+}
+
 // Interface is the interface that stores and retrieves monitoring results.
-// TODO(gbelvin): make multi-tenant.
+// Results are stored per Key Transparency server URL and directory, so that a
+// single store can hold the results of many monitored directories.
 type Interface interface {
 	// Set stores the monitoring result for a specific revision.
-	Set(revision int64, r *Result) error
+	Set(ctx context.Context, ktURL, directoryID string, revision int64, r *Result) error
 	// Get retrieves the monitoring result for a specific revision.
-	Get(revision int64) (*Result, error)
+	Get(ctx context.Context, ktURL, directoryID string, revision int64) (*Result, error)
 	// LatestRevision returns the highest numbered revision that has been processed.
-	LatestRevision() int64
+	// Returns ErrNotFound if no revision has been processed yet.
+	LatestRevision(ctx context.Context, ktURL, directoryID string) (int64, error)
+
+	// AddEquivocation records proof that a server signed conflicting map roots.
+	// Adding the same proof more than once has no effect.
+	AddEquivocation(ctx context.Context, e *mopb.Equivocation) error
+	// Equivocations returns the equivocation proofs recorded for a directory,
+	// ordered by revision.
+	Equivocations(ctx context.Context, ktURL, directoryID string) ([]*mopb.Equivocation, error)
 }
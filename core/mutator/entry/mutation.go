@@ -18,8 +18,10 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"time"
 
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/golang/protobuf/ptypes"
 	"github.com/google/keytransparency/core/crypto/commitments"
 	"github.com/google/tink/go/keyset"
 	"github.com/google/tink/go/tink"
@@ -36,6 +38,8 @@ type Mutation struct {
 	prevSignedEntry *pb.SignedEntry
 	entry           *pb.Entry
 	signedEntry     *pb.SignedEntry
+	// partial holds signatures collected with Sign and AddSignatures.
+	partial *pb.SignedEntry
 }
 
 // NewMutation creates a mutation object from a previous value which can be modified.
@@ -58,7 +62,8 @@ func NewMutation(index []byte, directoryID, userID string) *Mutation {
 // If the hash is missmatched, the server will not apply the mutation.
 // If Previous is unset, the server will not perform this check.
 //
-// If copyPrevious is true, AuthorizedKeys and Commitment are also copied.
+// If copyPrevious is true, AuthorizedKeys, Commitment, the signature threshold, the recovery settings and the
+// validity period are also copied.
 // oldValueRevision is the map revision that oldValue was fetched at.
 func (m *Mutation) SetPrevious(oldValueRevision uint64, oldValue []byte, copyPrevious bool) error {
 	prevSignedEntry, err := FromLeafValue(oldValue)
@@ -78,6 +83,11 @@ func (m *Mutation) SetPrevious(oldValueRevision uint64, oldValue []byte, copyPre
 	if copyPrevious {
 		m.entry.AuthorizedKeyset = prevEntry.GetAuthorizedKeyset()
 		m.entry.Commitment = prevEntry.GetCommitment()
+		m.entry.RecoveryKeyset = prevEntry.GetRecoveryKeyset()
+		m.entry.RecoveryDelay = prevEntry.GetRecoveryDelay()
+		m.entry.SignatureThreshold = prevEntry.GetSignatureThreshold()
+		m.entry.NotBefore = prevEntry.GetNotBefore()
+		m.entry.NotAfter = prevEntry.GetNotAfter()
 	}
 	return nil
 }
@@ -114,18 +124,95 @@ func (m *Mutation) ReplaceAuthorizedKeys(handle *keyset.Handle) error {
 	return nil
 }
 
+// SetSignatureThreshold requires the next entry to be signed by k distinct
+// authorized keys.
+func (m *Mutation) SetSignatureThreshold(k int32) {
+	m.entry.SignatureThreshold = k
+}
+
+// SetRecoveryKeys allows keys in handle to replace this entry once delay
+// revisions have passed without the owner cancelling the recovery.
+func (m *Mutation) SetRecoveryKeys(handle *keyset.Handle, delay int64) error {
+	var b bytes.Buffer
+	if err := handle.WriteWithNoSecrets(keyset.NewBinaryWriter(&b)); err != nil {
+		return err
+	}
+	m.entry.RecoveryKeyset = b.Bytes()
+	m.entry.RecoveryDelay = delay
+	return nil
+}
+
+// SetValidityPeriod limits the time during which clients accept the entry.
+// A zero notBefore or notAfter leaves that end of the period open.
+func (m *Mutation) SetValidityPeriod(notBefore, notAfter time.Time) error {
+	m.entry.NotBefore, m.entry.NotAfter = nil, nil
+	if !notBefore.IsZero() {
+		ts, err := ptypes.TimestampProto(notBefore)
+		if err != nil {
+			return err
+		}
+		m.entry.NotBefore = ts
+	}
+	if !notAfter.IsZero() {
+		ts, err := ptypes.TimestampProto(notAfter)
+		if err != nil {
+			return err
+		}
+		m.entry.NotAfter = ts
+	}
+	return nil
+}
+
+// Delete turns this mutation into a tombstone that deletes the entry. The
+// tombstone must be signed by the authorized keys of the entry it deletes.
+func (m *Mutation) Delete() {
+	m.data, m.nonce = nil, nil
+	m.entry = &pb.Entry{
+		Index:    m.entry.GetIndex(),
+		Previous: m.entry.GetPrevious(),
+		Deleted:  true,
+	}
+}
+
+// SetAdminOverride marks this mutation as an administrative override. An
+// override must be signed by the admin keys of the directory rather than by
+// the authorized keys of the entry it replaces.
+func (m *Mutation) SetAdminOverride() {
+	m.entry.AdminOverride = true
+}
+
+// IsPendingRecovery returns true if leafValue holds this mutation as a
+// pending recovery.
+func (m *Mutation) IsPendingRecovery(leafValue *pb.SignedEntry) bool {
+	pending := leafValue.GetPendingRecovery().GetRecovery()
+	return pending != nil && proto.Equal(pending, m.signedEntry)
+}
+
 // SerializeAndSign produces the mutation.
+// The mutation includes signatures collected with Sign and AddSignatures.
 func (m *Mutation) SerializeAndSign(signers []tink.Signer) (*pb.EntryUpdate, error) {
 	mutation, err := m.sign(signers)
 	if err != nil {
 		return nil, err
 	}
 
-	// Sanity check the mutation's correctness.
-	if _, err := MutateFn(m.prevSignedEntry, mutation); err != nil {
+	// Sanity check the mutation's correctness. Whether a deleted entry may
+	// be registered again is up to the directory, so allow it here.
+	// Administrative overrides are checked against the directory's admin
+	// keyset by the server.
+	if m.entry.GetAdminOverride() {
+		if err := IsValidEntry(mutation); err != nil {
+			return nil, err
+		}
+	} else if _, err := mutateFn(m.prevSignedEntry, mutation, m.MinApplyRevision(),
+		Options{AllowReregistration: true}); err != nil {
 		return nil, err
 	}
 
+	if m.entry.GetDeleted() {
+		// Tombstones do not commit to any data.
+		return &pb.EntryUpdate{UserId: m.UserID, Mutation: mutation}, nil
+	}
 	return &pb.EntryUpdate{
 		UserId:   m.UserID,
 		Mutation: mutation,
@@ -136,13 +223,85 @@ func (m *Mutation) SerializeAndSign(signers []tink.Signer) (*pb.EntryUpdate, err
 	}, nil
 }
 
-// Sign produces the mutation
-func (m *Mutation) sign(signers []tink.Signer) (*pb.SignedEntry, error) {
+// Sign adds signatures from signers to the signatures collected so far and
+// returns the partially signed entry. When several key holders must sign,
+// the partially signed entry can be passed to each of them in turn to sign
+// with CoSign, and the result passed back to AddSignatures.
+func (m *Mutation) Sign(signers []tink.Signer) (*pb.SignedEntry, error) {
+	entryData, err := m.collected()
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := signEntry(entryData, signers)
+	if err != nil {
+		return nil, err
+	}
+	m.partial.Signatures = append(m.partial.Signatures, sigs...)
+	return proto.Clone(m.partial).(*pb.SignedEntry), nil
+}
+
+// AddSignatures adds the signatures in signed to the signatures collected so
+// far. signed must be a signed copy of this mutation's entry.
+func (m *Mutation) AddSignatures(signed *pb.SignedEntry) error {
+	entryData, err := m.collected()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(signed.GetEntry(), entryData) {
+		return fmt.Errorf("signatures are for a different entry")
+	}
+	for _, sig := range signed.GetSignatures() {
+		if !containsSig(m.partial.Signatures, sig) {
+			m.partial.Signatures = append(m.partial.Signatures, sig)
+		}
+	}
+	return nil
+}
+
+// CoSign adds signatures from signers to a partially signed entry.
+func CoSign(signed *pb.SignedEntry, signers []tink.Signer) (*pb.SignedEntry, error) {
+	sigs, err := signEntry(signed.GetEntry(), signers)
+	if err != nil {
+		return nil, err
+	}
+	ret := proto.Clone(signed).(*pb.SignedEntry)
+	ret.Signatures = append(ret.Signatures, sigs...)
+	return ret, nil
+}
+
+// collected returns the serialized entry. Signatures collected for a previous
+// version of the entry are discarded.
+func (m *Mutation) collected() ([]byte, error) {
 	entryData, err := proto.Marshal(m.entry)
 	if err != nil {
 		return nil, fmt.Errorf("proto.Marshal(): %v", err)
 	}
+	if m.partial == nil || !bytes.Equal(m.partial.GetEntry(), entryData) {
+		m.partial = &pb.SignedEntry{Entry: entryData}
+	}
+	return entryData, nil
+}
+
+// sign produces the mutation, including any collected signatures.
+func (m *Mutation) sign(signers []tink.Signer) (*pb.SignedEntry, error) {
+	entryData, err := m.collected()
+	if err != nil {
+		return nil, err
+	}
+	sigs, err := signEntry(entryData, signers)
+	if err != nil {
+		return nil, err
+	}
+
+	m.signedEntry = &pb.SignedEntry{
+		Entry:      entryData,
+		Signatures: append(append([][]byte{}, m.partial.Signatures...), sigs...),
+	}
+	return m.signedEntry, nil
+}
 
+// signEntry signs entryData with each of signers.
+func signEntry(entryData []byte, signers []tink.Signer) ([][]byte, error) {
 	sigs := make([][]byte, 0, len(signers))
 	for _, signer := range signers {
 		sig, err := signer.Sign(entryData)
@@ -151,12 +310,16 @@ func (m *Mutation) sign(signers []tink.Signer) (*pb.SignedEntry, error) {
 		}
 		sigs = append(sigs, sig)
 	}
+	return sigs, nil
+}
 
-	m.signedEntry = &pb.SignedEntry{
-		Entry:      entryData,
-		Signatures: sigs,
+func containsSig(sigs [][]byte, sig []byte) bool {
+	for _, s := range sigs {
+		if bytes.Equal(s, sig) {
+			return true
+		}
 	}
-	return m.signedEntry, nil
+	return false
 }
 
 // EqualsRequested verifies that an update was successfully applied.
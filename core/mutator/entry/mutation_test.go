@@ -114,7 +114,7 @@ func TestCreateAndVerify(t *testing.T) {
 			if err != nil {
 				t.Fatalf("FromLeafValue(%v): %v", tc.old, err)
 			}
-			newSignedEntry, err := MutateFn(oldSignedEntry, update.GetMutation())
+			newSignedEntry, err := MutateFn(oldSignedEntry, update.GetMutation(), 1)
 			if err != nil {
 				t.Fatalf("Mutate(%v): %v", update.GetMutation(), err)
 			}
@@ -130,3 +130,70 @@ func TestCreateAndVerify(t *testing.T) {
 		})
 	}
 }
+
+func TestSignatureThreshold(t *testing.T) {
+	userID := "alice"
+	// Create an entry that requires signatures from both keys.
+	first := NewMutation([]byte{}, directoryID, userID)
+	if err := first.SetPrevious(0, nil, true); err != nil {
+		t.Fatalf("SetPrevious(): %v", err)
+	}
+	if err := first.ReplaceAuthorizedKeys(testutil.VerifyKeysetFromPEMs(testPubKey1, testPubKey2)); err != nil {
+		t.Fatalf("ReplaceAuthorizedKeys(): %v", err)
+	}
+	first.SetSignatureThreshold(2)
+	if _, err := first.SerializeAndSign(testutil.SignKeysetsFromPEMs(testPrivKey1)); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("SerializeAndSign(1 of 2): %v, want %v", err, codes.PermissionDenied)
+	}
+	update, err := first.SerializeAndSign(testutil.SignKeysetsFromPEMs(testPrivKey1, testPrivKey2))
+	if err != nil {
+		t.Fatalf("SerializeAndSign(2 of 2): %v", err)
+	}
+	old, err := ToLeafValue(update.GetMutation())
+	if err != nil {
+		t.Fatalf("ToLeafValue(): %v", err)
+	}
+
+	for _, tc := range []struct {
+		desc   string
+		rounds [][]tink.Signer
+		want   codes.Code
+	}{
+		{desc: "one key", rounds: [][]tink.Signer{testutil.SignKeysetsFromPEMs(testPrivKey1)}, want: codes.PermissionDenied},
+		{desc: "same key twice", rounds: [][]tink.Signer{
+			testutil.SignKeysetsFromPEMs(testPrivKey1),
+			testutil.SignKeysetsFromPEMs(testPrivKey1),
+		}, want: codes.PermissionDenied},
+		{desc: "two rounds", rounds: [][]tink.Signer{
+			testutil.SignKeysetsFromPEMs(testPrivKey1),
+			testutil.SignKeysetsFromPEMs(testPrivKey2),
+		}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			m := NewMutation([]byte{}, directoryID, userID)
+			if err := m.SetPrevious(1, old, true); err != nil {
+				t.Fatalf("SetPrevious(): %v", err)
+			}
+			if err := m.SetCommitment([]byte("bar")); err != nil {
+				t.Fatalf("SetCommitment(): %v", err)
+			}
+			partial, err := m.Sign(tc.rounds[0])
+			if err != nil {
+				t.Fatalf("Sign(): %v", err)
+			}
+			// Pass the partially signed entry to the other key holders.
+			for _, signers := range tc.rounds[1:] {
+				if partial, err = CoSign(partial, signers); err != nil {
+					t.Fatalf("CoSign(): %v", err)
+				}
+			}
+			if err := m.AddSignatures(partial); err != nil {
+				t.Fatalf("AddSignatures(): %v", err)
+			}
+			_, err = m.SerializeAndSign(nil)
+			if got := status.Code(err); got != tc.want {
+				t.Errorf("SerializeAndSign(): %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
@@ -22,14 +22,18 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/tink/go/core/cryptofmt"
+	"github.com/google/tink/go/core/primitiveset"
 	"github.com/google/tink/go/keyset"
 	"github.com/google/tink/go/signature"
+	"github.com/google/tink/go/tink"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/google/keytransparency/core/mutator"
 
 	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	tinkpb "github.com/google/tink/go/proto/tink_go_proto"
 )
 
 // MapLogItemFn maps elements from *mutator.LogMessage to KV<index, *pb.EntryUpdate>.
@@ -58,17 +62,85 @@ func IsValidEntry(signedEntry *pb.SignedEntry) error {
 	if err := proto.Unmarshal(signedEntry.GetEntry(), &newEntry); err != nil {
 		return status.Errorf(codes.InvalidArgument, "proto.Unmarshal(): %v", err)
 	}
+	if newEntry.GetDeleted() {
+		// Tombstones are authorized by the keys of the entry they delete.
+		return isValidTombstone(&newEntry)
+	}
+	if err := checkValidityPeriod(&newEntry); err != nil {
+		return err
+	}
 
 	ks, err := keyset.ReadWithNoSecrets(keyset.NewBinaryReader(
 		bytes.NewBuffer(newEntry.GetAuthorizedKeyset())))
 	if err != nil {
 		return err
 	}
-	return verifyKeys(ks, signedEntry.GetEntry(), signedEntry.GetSignatures())
+	if newEntry.GetAdminOverride() {
+		// Overrides are authorized by the admin keyset of the directory.
+		return nil
+	}
+	// Prove ownership of enough of the new keys to authorize the next entry.
+	return verifyKeys(ks, signedEntry.GetEntry(), signedEntry.GetSignatures(), newEntry.GetSignatureThreshold())
+}
+
+// isValidTombstone checks that a tombstone carries no data and no keys.
+func isValidTombstone(e *pb.Entry) error {
+	if len(e.GetCommitment()) != 0 ||
+		len(e.GetAuthorizedKeyset()) != 0 ||
+		len(e.GetRecoveryKeyset()) != 0 ||
+		e.GetRecoveryDelay() != 0 ||
+		e.GetSignatureThreshold() != 0 ||
+		e.GetNotBefore() != nil ||
+		e.GetNotAfter() != nil {
+		return status.Errorf(codes.InvalidArgument, "mutation: tombstone must not have a commitment, keys or validity period")
+	}
+	return nil
+}
+
+// IsDeleted returns true if signedEntry is a tombstone.
+func IsDeleted(signedEntry *pb.SignedEntry) bool {
+	var e pb.Entry
+	if err := proto.Unmarshal(signedEntry.GetEntry(), &e); err != nil {
+		return false
+	}
+	return e.GetDeleted()
+}
+
+// IsAdminOverride returns true if signedEntry is an administrative override.
+func IsAdminOverride(signedEntry *pb.SignedEntry) bool {
+	var e pb.Entry
+	if err := proto.Unmarshal(signedEntry.GetEntry(), &e); err != nil {
+		return false
+	}
+	return e.GetAdminOverride()
 }
 
-// ReduceFn decides which of multiple updates can be applied in this revision.
-func ReduceFn(leaves []*pb.EntryUpdate, msgs []*pb.EntryUpdate,
+// VerifyAdminOverride verifies that signedEntry is signed by a key in
+// adminKeyset, a serialized tink keyset without secrets.
+func VerifyAdminOverride(signedEntry *pb.SignedEntry, adminKeyset []byte) error {
+	if len(adminKeyset) == 0 {
+		glog.Warningf("directory has no admin keyset")
+		return mutator.ErrUnauthorized
+	}
+	handle, err := keyset.ReadWithNoSecrets(keyset.NewBinaryReader(bytes.NewBuffer(adminKeyset)))
+	if err != nil {
+		return err
+	}
+	return verifyKeys(handle, signedEntry.GetEntry(), signedEntry.GetSignatures(), 1)
+}
+
+// NewReduceFn returns a function that uses mutate to decide which of multiple
+// updates can be applied in revision.
+func NewReduceFn(mutate mutator.MutateFn, revision int64) func(leaves []*pb.EntryUpdate, msgs []*pb.EntryUpdate,
+	emit func(*pb.EntryUpdate), emitErr func(error)) {
+	return func(leaves []*pb.EntryUpdate, msgs []*pb.EntryUpdate,
+		emit func(*pb.EntryUpdate), emitErr func(error)) {
+		reduceFn(mutate, revision, leaves, msgs, emit, emitErr)
+	}
+}
+
+// reduceFn decides which of multiple updates can be applied in revision.
+func reduceFn(mutate mutator.MutateFn, revision int64, leaves []*pb.EntryUpdate, msgs []*pb.EntryUpdate,
 	emit func(*pb.EntryUpdate), emitErr func(error)) {
 	if got := len(leaves); got > 1 {
 		emitErr(status.Errorf(codes.Internal, "got %v map leaves, want 0 or 1", got))
@@ -87,15 +159,21 @@ func ReduceFn(leaves []*pb.EntryUpdate, msgs []*pb.EntryUpdate,
 	// Filter for mutations that are valid.
 	newEntries := make([]*pb.EntryUpdate, 0, len(msgs))
 	for i, msg := range msgs {
-		newValue, err := MutateFn(oldValue, msg.GetMutation())
+		newValue, err := mutate(oldValue, msg.GetMutation(), revision)
 		if err != nil {
 			s := status.Convert(err)
 			emitErr(status.Errorf(s.Code(), "entry: ReduceFn(msg %d/%d): %v", i+1, len(msgs), s.Message()))
 			continue
 		}
+		committed := msg.GetCommitted()
+		if newValue.GetPendingRecovery() != nil && len(leaves) > 0 {
+			// A pending recovery keeps the old entry, so keep the data
+			// that its commitment opens to until the recovery is applied.
+			committed = leaves[0].GetCommitted()
+		}
 		newEntries = append(newEntries, &pb.EntryUpdate{
 			Mutation:  newValue,
-			Committed: msg.GetCommitted(),
+			Committed: committed,
 		})
 	}
 	if len(newEntries) == 0 {
@@ -111,11 +189,29 @@ func ReduceFn(leaves []*pb.EntryUpdate, msgs []*pb.EntryUpdate,
 }
 
 // MutateFn verifies that newSignedEntry is a valid mutation for oldSignedEntry and returns the
-// application of newSignedEntry to oldSignedEntry.
-func MutateFn(oldSignedEntry, newSignedEntry *pb.SignedEntry) (*pb.SignedEntry, error) {
+// application of newSignedEntry to oldSignedEntry in revision.
+//
+// If newSignedEntry is signed by the recovery keyset of oldSignedEntry rather
+// than its authorized keyset, MutateFn returns oldSignedEntry with
+// newSignedEntry attached as a pending recovery. The recovery is applied once
+// it is resubmitted in a revision at least RecoveryDelay revisions after it
+// was first requested.
+//
+// If oldSignedEntry is a tombstone, MutateFn returns mutator.ErrDeleted.
+// MutateFn rejects administrative overrides. Use GetMutator with an admin
+// keyset to accept them.
+func MutateFn(oldSignedEntry, newSignedEntry *pb.SignedEntry, revision int64) (*pb.SignedEntry, error) {
+	return mutateFn(oldSignedEntry, newSignedEntry, revision, Options{})
+}
+
+// mutateFn implements MutateFn, configured by opts.
+func mutateFn(oldSignedEntry, newSignedEntry *pb.SignedEntry, revision int64, opts Options) (*pb.SignedEntry, error) {
 	if err := IsValidEntry(newSignedEntry); err != nil {
 		return nil, err
 	}
+	if newSignedEntry.GetPendingRecovery() != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "mutation: pending_recovery must not be set by clients")
+	}
 
 	newEntry := pb.Entry{}
 	if err := proto.Unmarshal(newSignedEntry.GetEntry(), &newEntry); err != nil {
@@ -142,40 +238,135 @@ func MutateFn(oldSignedEntry, newSignedEntry *pb.SignedEntry) (*pb.SignedEntry,
 		}
 	}
 
+	if newEntry.GetDeleted() && (oldSignedEntry == nil || oldEntry.GetDeleted()) {
+		return nil, status.Errorf(codes.FailedPrecondition, "mutation: there is no entry to delete")
+	}
+	if newEntry.GetAdminOverride() {
+		// Overrides replace the entry regardless of the keys in oldEntry.
+		if err := VerifyAdminOverride(newSignedEntry, opts.AdminKeyset); err != nil {
+			return nil, err
+		}
+		return newSignedEntry, nil
+	}
 	if oldSignedEntry == nil {
 		// Skip verificaion checks if there is no previous oldSignedEntry.
 		return newSignedEntry, nil
 	}
+	if oldEntry.GetDeleted() {
+		if !opts.AllowReregistration {
+			return nil, mutator.ErrDeleted
+		}
+		// A deleted entry has no keys to authorize the new entry.
+		return newSignedEntry, nil
+	}
 
 	handle, err := keyset.ReadWithNoSecrets(keyset.NewBinaryReader(
 		bytes.NewBuffer(oldEntry.GetAuthorizedKeyset())))
 	if err != nil {
 		return nil, err
 	}
-	if err := verifyKeys(handle, newSignedEntry.Entry, newSignedEntry.GetSignatures()); err != nil {
+	err = verifyKeys(handle, newSignedEntry.Entry, newSignedEntry.GetSignatures(), oldEntry.GetSignatureThreshold())
+	if err == mutator.ErrUnauthorized && oldEntry.GetRecoveryKeyset() != nil {
+		return applyRecovery(oldSignedEntry, &oldEntry, newSignedEntry, revision)
+	}
+	if err != nil {
 		return nil, err
 	}
 
+	// Updates signed by the authorized keyset also cancel any pending recovery.
 	return newSignedEntry, nil
 }
 
+// applyRecovery applies newSignedEntry to oldSignedEntry if newSignedEntry is signed
+// by the recovery keyset of oldEntry and the recovery delay has passed.
+// Otherwise it returns oldSignedEntry with newSignedEntry pending.
+func applyRecovery(oldSignedEntry *pb.SignedEntry, oldEntry *pb.Entry,
+	newSignedEntry *pb.SignedEntry, revision int64) (*pb.SignedEntry, error) {
+	handle, err := keyset.ReadWithNoSecrets(keyset.NewBinaryReader(
+		bytes.NewBuffer(oldEntry.GetRecoveryKeyset())))
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyKeys(handle, newSignedEntry.Entry, newSignedEntry.GetSignatures(), 1); err != nil {
+		return nil, err
+	}
+
+	requested := revision
+	if pending := oldSignedEntry.GetPendingRecovery(); pending != nil &&
+		bytes.Equal(pending.GetRecovery().GetEntry(), newSignedEntry.GetEntry()) {
+		requested = pending.GetRequestedRevision()
+	}
+	if revision >= requested+oldEntry.GetRecoveryDelay() {
+		return newSignedEntry, nil
+	}
+	if requested != revision {
+		glog.Warningf("recovery requested in revision %v is pending until revision %v",
+			requested, requested+oldEntry.GetRecoveryDelay())
+		return nil, mutator.ErrRecoveryPending
+	}
+	// Start a new recovery, replacing any other pending recovery.
+	return &pb.SignedEntry{
+		Entry:      oldSignedEntry.GetEntry(),
+		Signatures: oldSignedEntry.GetSignatures(),
+		PendingRecovery: &pb.PendingRecovery{
+			Recovery:          newSignedEntry,
+			RequestedRevision: revision,
+		},
+	}, nil
+}
+
 // verifyKeys verifies both old and new authorized keys based on the following
 // criteria:
-//   1. At least one signature with a key in the entry should exist.
+//   1. Signatures from at least threshold distinct keys in the entry should
+//      exist. A threshold less than 1 requires one signature.
 //   2. Signatures with no matching keys are simply ignored.
-func verifyKeys(handle *keyset.Handle, data []byte, sigs [][]byte) error {
+func verifyKeys(handle *keyset.Handle, data []byte, sigs [][]byte, threshold int32) error {
 	if handle == nil {
 		return errors.New("entry: nil keyset")
 	}
-	verifier, err := signature.NewVerifier(handle)
-	if err != nil {
+	if _, err := signature.NewVerifier(handle); err != nil {
 		return status.Errorf(codes.InvalidArgument, "signature.NewVerifier(%v): %v", handle, err)
 	}
+	ps, err := handle.Primitives()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "Primitives(%v): %v", handle, err)
+	}
 
+	signers := make(map[uint32]bool)
 	for _, sig := range sigs {
-		if err := verifier.Verify(sig, data); err == nil {
-			return nil
+		if keyID, ok := signingKeyID(ps, data, sig); ok {
+			signers[keyID] = true
+		}
+	}
+	if threshold < 1 {
+		threshold = 1
+	}
+	if int32(len(signers)) < threshold {
+		return mutator.ErrUnauthorized
+	}
+	return nil
+}
+
+// signingKeyID returns the ID of the key in ps that produced sig over data.
+func signingKeyID(ps *primitiveset.PrimitiveSet, data, sig []byte) (uint32, bool) {
+	for prefix, entries := range ps.Entries {
+		// Raw keys have an empty prefix.
+		if !bytes.HasPrefix(sig, []byte(prefix)) {
+			continue
+		}
+		for _, e := range entries {
+			verifier, ok := e.Primitive.(tink.Verifier)
+			if !ok {
+				continue
+			}
+			signedData := data
+			if e.PrefixType == tinkpb.OutputPrefixType_LEGACY {
+				signedData = append(append([]byte{}, data...), cryptofmt.LegacyStartByte)
+			}
+			if err := verifier.Verify(sig[len(prefix):], signedData); err == nil {
+				return e.KeyID, true
+			}
 		}
 	}
-	return mutator.ErrUnauthorized
+	return 0, false
 }
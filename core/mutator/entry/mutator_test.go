@@ -23,6 +23,8 @@ import (
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
 	"github.com/google/tink/go/keyset"
 	"github.com/google/tink/go/tink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/google/keytransparency/core/mutator"
 	"github.com/google/keytransparency/core/testutil"
@@ -184,9 +186,210 @@ func TestCheckMutation(t *testing.T) {
 			if err != nil {
 				t.Fatalf("mutation.sign(%v): %v", tc.signers, err)
 			}
-			if _, got := MutateFn(tc.old, m); got != tc.err {
+			if _, got := MutateFn(tc.old, m, 1); got != tc.err {
 				t.Errorf("%v Mutate(): %v, want %v", tc.desc, got, tc.err)
 			}
 		})
 	}
 }
+
+func TestRecovery(t *testing.T) {
+	key := []byte{0}
+	sign := func(e *tpb.Entry, privKeys ...string) *tpb.SignedEntry {
+		m := &Mutation{entry: e}
+		signed, err := m.sign(testutil.SignKeysetsFromPEMs(privKeys...))
+		if err != nil {
+			t.Fatalf("sign(): %v", err)
+		}
+		return signed
+	}
+	// The owner holds key 1 and has designated key 2 for recovery.
+	owned := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{1},
+		AuthorizedKeyset: keysetBytes(testPubKey1),
+		RecoveryKeyset:   keysetBytes(testPubKey2),
+		RecoveryDelay:    3,
+	}, testPrivKey1)
+	noRecovery := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{1},
+		AuthorizedKeyset: keysetBytes(testPubKey1),
+	}, testPrivKey1)
+	immediate := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{1},
+		AuthorizedKeyset: keysetBytes(testPubKey1),
+		RecoveryKeyset:   keysetBytes(testPubKey2),
+	}, testPrivKey1)
+	recovery := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{2},
+		AuthorizedKeyset: keysetBytes(testPubKey2),
+	}, testPrivKey2)
+	otherRecovery := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{3},
+		AuthorizedKeyset: keysetBytes(testPubKey2),
+	}, testPrivKey2)
+	cancel := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{4},
+		AuthorizedKeyset: keysetBytes(testPubKey1),
+	}, testPrivKey1)
+	pending := &tpb.SignedEntry{
+		Entry:      owned.Entry,
+		Signatures: owned.Signatures,
+		PendingRecovery: &tpb.PendingRecovery{
+			Recovery:          recovery,
+			RequestedRevision: 10,
+		},
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		old, new *tpb.SignedEntry
+		revision int64
+		want     *tpb.SignedEntry
+		err      error
+	}{
+		{desc: "no recovery keyset", old: noRecovery, new: recovery, revision: 10, err: mutator.ErrUnauthorized},
+		{desc: "start recovery", old: owned, new: recovery, revision: 10, want: pending},
+		{desc: "too early", old: pending, new: recovery, revision: 12, err: mutator.ErrRecoveryPending},
+		{desc: "delay passed", old: pending, new: recovery, revision: 13, want: recovery},
+		{desc: "no delay", old: immediate, new: recovery, revision: 10, want: recovery},
+		{desc: "cancel", old: pending, new: cancel, revision: 11, want: cancel},
+		{desc: "replace pending", old: pending, new: otherRecovery, revision: 11, want: &tpb.SignedEntry{
+			Entry:      owned.Entry,
+			Signatures: owned.Signatures,
+			PendingRecovery: &tpb.PendingRecovery{
+				Recovery:          otherRecovery,
+				RequestedRevision: 11,
+			},
+		}},
+		{desc: "client set pending", old: owned, new: pending, revision: 10, err: status.Error(codes.InvalidArgument, "")},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := MutateFn(tc.old, tc.new, tc.revision)
+			if status.Code(err) != status.Code(tc.err) {
+				t.Fatalf("MutateFn(): %v, want %v", err, tc.err)
+			}
+			if !proto.Equal(got, tc.want) {
+				t.Errorf("MutateFn(): %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTombstone(t *testing.T) {
+	key := []byte{0}
+	sign := func(e *tpb.Entry, privKeys ...string) *tpb.SignedEntry {
+		m := &Mutation{entry: e}
+		signed, err := m.sign(testutil.SignKeysetsFromPEMs(privKeys...))
+		if err != nil {
+			t.Fatalf("sign(): %v", err)
+		}
+		return signed
+	}
+	owned := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{1},
+		AuthorizedKeyset: keysetBytes(testPubKey1),
+	}, testPrivKey1)
+	tombstone := sign(&tpb.Entry{Index: key, Deleted: true}, testPrivKey1)
+	wrongKey := sign(&tpb.Entry{Index: key, Deleted: true}, testPrivKey2)
+	withData := sign(&tpb.Entry{Index: key, Deleted: true, Commitment: []byte{1}}, testPrivKey1)
+	reregister := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{2},
+		AuthorizedKeyset: keysetBytes(testPubKey2),
+	}, testPrivKey2)
+
+	for _, tc := range []struct {
+		desc     string
+		opts     Options
+		old, new *tpb.SignedEntry
+		want     codes.Code
+	}{
+		{desc: "delete", old: owned, new: tombstone},
+		{desc: "delete with wrong key", old: owned, new: wrongKey, want: codes.PermissionDenied},
+		{desc: "delete with data", old: owned, new: withData, want: codes.InvalidArgument},
+		{desc: "delete missing entry", old: nil, new: tombstone, want: codes.FailedPrecondition},
+		{desc: "register deleted entry", old: tombstone, new: reregister, want: codes.FailedPrecondition},
+		{desc: "reregister deleted entry", opts: Options{AllowReregistration: true}, old: tombstone, new: reregister},
+		{desc: "reregister deleted entry with tombstone", opts: Options{AllowReregistration: true}, old: tombstone, new: wrongKey, want: codes.FailedPrecondition},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, err := mutateFn(tc.old, tc.new, 1, tc.opts)
+			if status.Code(err) != tc.want {
+				t.Fatalf("MutateFn(): %v, want %v", err, tc.want)
+			}
+			if err == nil && !proto.Equal(got, tc.new) {
+				t.Errorf("MutateFn(): %v, want %v", got, tc.new)
+			}
+		})
+	}
+	if !IsDeleted(tombstone) || IsDeleted(owned) {
+		t.Errorf("IsDeleted() did not recognize the tombstone")
+	}
+}
+
+func TestAdminOverride(t *testing.T) {
+	key := []byte{0}
+	sign := func(e *tpb.Entry, privKeys ...string) *tpb.SignedEntry {
+		m := &Mutation{entry: e}
+		signed, err := m.sign(testutil.SignKeysetsFromPEMs(privKeys...))
+		if err != nil {
+			t.Fatalf("sign(): %v", err)
+		}
+		return signed
+	}
+	adminKeyset := keysetBytes(testPubKey2)
+	owned := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{1},
+		AuthorizedKeyset: keysetBytes(testPubKey1),
+	}, testPrivKey1)
+	override := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{2},
+		AuthorizedKeyset: keysetBytes(testPubKey2),
+		AdminOverride:    true,
+	}, testPrivKey2)
+	userSigned := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{2},
+		AuthorizedKeyset: keysetBytes(testPubKey1),
+		AdminOverride:    true,
+	}, testPrivKey1)
+	deleteOverride := sign(&tpb.Entry{Index: key, Deleted: true, AdminOverride: true}, testPrivKey2)
+
+	for _, tc := range []struct {
+		desc     string
+		opts     Options
+		old, new *tpb.SignedEntry
+		want     codes.Code
+	}{
+		{desc: "override", opts: Options{AdminKeyset: adminKeyset}, old: owned, new: override},
+		{desc: "override missing entry", opts: Options{AdminKeyset: adminKeyset}, old: nil, new: override},
+		{desc: "delete override", opts: Options{AdminKeyset: adminKeyset}, old: owned, new: deleteOverride},
+		{desc: "no admin keyset", old: owned, new: override, want: codes.PermissionDenied},
+		{desc: "signed by user", opts: Options{AdminKeyset: adminKeyset}, old: owned, new: userSigned, want: codes.PermissionDenied},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			if err := IsValidEntry(tc.new); err != nil {
+				t.Fatalf("IsValidEntry(): %v", err)
+			}
+			got, err := mutateFn(tc.old, tc.new, 1, tc.opts)
+			if status.Code(err) != tc.want {
+				t.Fatalf("MutateFn(): %v, want %v", err, tc.want)
+			}
+			if err == nil && !proto.Equal(got, tc.new) {
+				t.Errorf("MutateFn(): %v, want %v", got, tc.new)
+			}
+		})
+	}
+	if !IsAdminOverride(override) || IsAdminOverride(owned) {
+		t.Errorf("IsAdminOverride() did not recognize the override")
+	}
+}
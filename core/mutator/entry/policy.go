@@ -0,0 +1,167 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/keytransparency/core/mutator"
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	tinkpb "github.com/google/tink/go/proto/tink_go_proto"
+)
+
+// Names of the mutators that directories can choose from.
+const (
+	// DefaultMutator lets the authorized keyset of an entry replace it.
+	DefaultMutator = ""
+	// AppendOnlyMutator lets the authorized keyset of an entry replace it, as
+	// long as every authorized key is kept.
+	AppendOnlyMutator = "append-only"
+	// FirstWriteWinsMutator accepts the first entry written for each index
+	// and rejects every later mutation.
+	FirstWriteWinsMutator = "first-write-wins"
+	// AdminOnlyMutator only accepts administrative overrides.
+	AdminOnlyMutator = "admin-only"
+)
+
+// Options configures the mutator of a directory.
+type Options struct {
+	// AllowReregistration lets new entries replace deleted entries.
+	AllowReregistration bool
+	// AdminKeyset is the serialized tink keyset that signs administrative
+	// overrides. Overrides are rejected if it is empty.
+	AdminKeyset []byte
+}
+
+// policy restricts the mutations that a base mutator accepts.
+type policy func(base *mutator.Mutator) *mutator.Mutator
+
+var mutators = map[string]policy{
+	DefaultMutator:        func(base *mutator.Mutator) *mutator.Mutator { return base },
+	AppendOnlyMutator:     appendOnly,
+	FirstWriteWinsMutator: firstWriteWins,
+	AdminOnlyMutator:      adminOnly,
+}
+
+// Mutators returns the names of the supported mutators.
+func Mutators() []string {
+	names := make([]string, 0, len(mutators))
+	for name := range mutators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetMutator returns the mutator called name, configured by opts.
+func GetMutator(name string, opts Options) (*mutator.Mutator, error) {
+	p, ok := mutators[name]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown mutator %q, want one of %q", name, Mutators())
+	}
+	return p(&mutator.Mutator{
+		VerifyMutation: IsValidEntry,
+		Mutate: func(oldSignedEntry, newSignedEntry *pb.SignedEntry, revision int64) (*pb.SignedEntry, error) {
+			return mutateFn(oldSignedEntry, newSignedEntry, revision, opts)
+		},
+	}), nil
+}
+
+// firstWriteWins applies newSignedEntry only if there is no oldSignedEntry.
+// Administrative overrides are always applied.
+func firstWriteWins(base *mutator.Mutator) *mutator.Mutator {
+	return &mutator.Mutator{
+		VerifyMutation: base.VerifyMutation,
+		Mutate: func(oldSignedEntry, newSignedEntry *pb.SignedEntry, revision int64) (*pb.SignedEntry, error) {
+			if oldSignedEntry != nil && !IsAdminOverride(newSignedEntry) {
+				return nil, mutator.ErrImmutable
+			}
+			return base.Mutate(oldSignedEntry, newSignedEntry, revision)
+		},
+	}
+}
+
+// appendOnly applies newSignedEntry with base and then checks that every
+// key in the authorized keyset of oldSignedEntry is still authorized.
+// Deleting the entry and administrative overrides are allowed.
+func appendOnly(base *mutator.Mutator) *mutator.Mutator {
+	return &mutator.Mutator{
+		VerifyMutation: base.VerifyMutation,
+		Mutate: func(oldSignedEntry, newSignedEntry *pb.SignedEntry, revision int64) (*pb.SignedEntry, error) {
+			newValue, err := base.Mutate(oldSignedEntry, newSignedEntry, revision)
+			if err != nil {
+				return nil, err
+			}
+			if oldSignedEntry == nil || IsDeleted(oldSignedEntry) || IsDeleted(newValue) ||
+				IsAdminOverride(newValue) {
+				return newValue, nil
+			}
+			oldKeys, err := authorizedKeys(oldSignedEntry)
+			if err != nil {
+				return nil, err
+			}
+			newKeys, err := authorizedKeys(newValue)
+			if err != nil {
+				return nil, err
+			}
+			for _, k := range oldKeys.GetKey() {
+				if !containsKey(newKeys, k) {
+					return nil, mutator.ErrKeyRemoved
+				}
+			}
+			return newValue, nil
+		},
+	}
+}
+
+// adminOnly rejects every mutation that is not an administrative override.
+func adminOnly(base *mutator.Mutator) *mutator.Mutator {
+	return &mutator.Mutator{
+		VerifyMutation: func(*pb.SignedEntry) error { return mutator.ErrUnauthorized },
+		Mutate: func(oldSignedEntry, newSignedEntry *pb.SignedEntry, revision int64) (*pb.SignedEntry, error) {
+			if !IsAdminOverride(newSignedEntry) {
+				return nil, mutator.ErrUnauthorized
+			}
+			return base.Mutate(oldSignedEntry, newSignedEntry, revision)
+		},
+	}
+}
+
+// authorizedKeys returns the authorized keyset of signedEntry.
+func authorizedKeys(signedEntry *pb.SignedEntry) (*tinkpb.Keyset, error) {
+	var entry pb.Entry
+	if err := proto.Unmarshal(signedEntry.GetEntry(), &entry); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "proto.Unmarshal(): %v", err)
+	}
+	var ks tinkpb.Keyset
+	if err := proto.Unmarshal(entry.GetAuthorizedKeyset(), &ks); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "proto.Unmarshal(): %v", err)
+	}
+	return &ks, nil
+}
+
+func containsKey(ks *tinkpb.Keyset, key *tinkpb.Keyset_Key) bool {
+	for _, k := range ks.GetKey() {
+		if k.GetKeyId() == key.GetKeyId() && proto.Equal(k.GetKeyData(), key.GetKeyData()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/keytransparency/core/mutator"
+	"github.com/google/keytransparency/core/testutil"
+
+	tpb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+)
+
+func TestGetMutator(t *testing.T) {
+	for _, name := range Mutators() {
+		if _, err := GetMutator(name, Options{}); err != nil {
+			t.Errorf("GetMutator(%q): %v", name, err)
+		}
+	}
+	if _, err := GetMutator("unknown", Options{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetMutator(unknown): %v, want %v", err, codes.InvalidArgument)
+	}
+}
+
+func TestMutators(t *testing.T) {
+	key := []byte{0}
+	sign := func(e *tpb.Entry, privKeys ...string) *tpb.SignedEntry {
+		m := &Mutation{entry: e}
+		signed, err := m.sign(testutil.SignKeysetsFromPEMs(privKeys...))
+		if err != nil {
+			t.Fatalf("sign(): %v", err)
+		}
+		return signed
+	}
+	old := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{1},
+		AuthorizedKeyset: keysetBytes(testPubKey1),
+	}, testPrivKey1)
+	addKey := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{2},
+		AuthorizedKeyset: keysetBytes(testPubKey1, testPubKey2),
+	}, testPrivKey1, testPrivKey2)
+	replaceKey := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{2},
+		AuthorizedKeyset: keysetBytes(testPubKey2),
+	}, testPrivKey1, testPrivKey2)
+	override := sign(&tpb.Entry{
+		Index:            key,
+		Commitment:       []byte{3},
+		AuthorizedKeyset: keysetBytes(testPubKey2),
+		AdminOverride:    true,
+	}, testPrivKey2)
+	opts := Options{AdminKeyset: keysetBytes(testPubKey2)}
+
+	for _, tc := range []struct {
+		mutator  string
+		old, new *tpb.SignedEntry
+		want     error
+	}{
+		{mutator: DefaultMutator, old: old, new: addKey},
+		{mutator: DefaultMutator, old: old, new: replaceKey},
+		{mutator: AppendOnlyMutator, old: nil, new: old},
+		{mutator: AppendOnlyMutator, old: old, new: addKey},
+		{mutator: AppendOnlyMutator, old: old, new: replaceKey, want: mutator.ErrKeyRemoved},
+		{mutator: FirstWriteWinsMutator, old: nil, new: old},
+		{mutator: FirstWriteWinsMutator, old: old, new: addKey, want: mutator.ErrImmutable},
+		{mutator: FirstWriteWinsMutator, old: old, new: override},
+		{mutator: AppendOnlyMutator, old: old, new: override},
+		{mutator: AdminOnlyMutator, old: nil, new: old, want: mutator.ErrUnauthorized},
+		{mutator: AdminOnlyMutator, old: old, new: override},
+	} {
+		m, err := GetMutator(tc.mutator, opts)
+		if err != nil {
+			t.Fatalf("GetMutator(%q): %v", tc.mutator, err)
+		}
+		if _, err := m.Mutate(tc.old, tc.new, 1); err != tc.want {
+			t.Errorf("%q Mutate(): %v, want %v", tc.mutator, err, tc.want)
+		}
+	}
+}
+
+func TestAdminOnlyVerifyMutation(t *testing.T) {
+	m, err := GetMutator(AdminOnlyMutator, Options{})
+	if err != nil {
+		t.Fatalf("GetMutator(): %v", err)
+	}
+	if err := m.VerifyMutation(&tpb.SignedEntry{}); err != mutator.ErrUnauthorized {
+		t.Errorf("VerifyMutation(): %v, want %v", err, mutator.ErrUnauthorized)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/keytransparency/core/client/verifier"
+	"github.com/google/keytransparency/core/crypto/vrf/p256"
+	"github.com/google/keytransparency/core/fake"
+	"github.com/google/keytransparency/core/mutator/entry"
+	"github.com/google/tink/go/keyset"
+	"github.com/google/tink/go/signature"
+	"github.com/google/tink/go/tink"
+	"github.com/google/trillian/crypto/keyspb"
+	"github.com/google/trillian/types"
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	tpb "github.com/google/trillian"
+	tclient "github.com/google/trillian/client"
+	sigpb "github.com/google/trillian/crypto/sigpb"
+
+	_ "github.com/google/trillian/merkle/coniks" // Register hasher
+)
+
+// newKeys returns signers for a new keyset and its public keys.
+func newKeys(t *testing.T) ([]tink.Signer, *keyset.Handle) {
+	t.Helper()
+	handle, err := keyset.NewHandle(signature.ECDSAP256KeyTemplate())
+	if err != nil {
+		t.Fatalf("keyset.NewHandle(): %v", err)
+	}
+	signer, err := signature.NewSigner(handle)
+	if err != nil {
+		t.Fatalf("signature.NewSigner(): %v", err)
+	}
+	pub, err := handle.Public()
+	if err != nil {
+		t.Fatalf("Public(): %v", err)
+	}
+	return []tink.Signer{signer}, pub
+}
+
+// TestReduceFnPendingRecovery verifies that users can still read their entry
+// while a recovery of it is pending.
+func TestReduceFnPendingRecovery(t *testing.T) {
+	ctx := context.Background()
+	const directoryID, userID = "directory", "alice"
+	vrfPriv, vrfPub := p256.GenerateKey()
+	index, vrfProof := vrfPriv.Evaluate([]byte(userID))
+	ownerSigners, ownerKeys := newKeys(t)
+	recoverySigners, recoveryKeys := newKeys(t)
+
+	owner := entry.NewMutation(index[:], directoryID, userID)
+	if err := owner.SetCommitment([]byte("owner data")); err != nil {
+		t.Fatal(err)
+	}
+	if err := owner.ReplaceAuthorizedKeys(ownerKeys); err != nil {
+		t.Fatal(err)
+	}
+	if err := owner.SetRecoveryKeys(recoveryKeys, 3); err != nil {
+		t.Fatal(err)
+	}
+	owned, err := owner.SerializeAndSign(ownerSigners)
+	if err != nil {
+		t.Fatalf("SerializeAndSign(owner): %v", err)
+	}
+	ownedValue, err := entry.ToLeafValue(owned.GetMutation())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recovery := entry.NewMutation(index[:], directoryID, userID)
+	if err := recovery.SetPrevious(1, ownedValue, false); err != nil {
+		t.Fatal(err)
+	}
+	if err := recovery.SetCommitment([]byte("recovered data")); err != nil {
+		t.Fatal(err)
+	}
+	if err := recovery.ReplaceAuthorizedKeys(recoveryKeys); err != nil {
+		t.Fatal(err)
+	}
+	recovered, err := recovery.SerializeAndSign(recoverySigners)
+	if err != nil {
+		t.Fatalf("SerializeAndSign(recovery): %v", err)
+	}
+
+	var got *pb.EntryUpdate
+	reduce := entry.NewReduceFn(entry.MutateFn, 2)
+	reduce([]*pb.EntryUpdate{owned}, []*pb.EntryUpdate{recovered},
+		func(e *pb.EntryUpdate) { got = e },
+		func(err error) { t.Errorf("ReduceFn(): %v", err) },
+	)
+	if got.GetMutation().GetPendingRecovery() == nil {
+		t.Fatalf("ReduceFn(): %v, want pending recovery", got)
+	}
+
+	// Write the new value to a map and read it back through the verifier.
+	ft := fake.NewTrillian()
+	tree, err := tclient.CreateAndInitTree(ctx, &tpb.CreateTreeRequest{
+		Tree: &tpb.Tree{
+			TreeState:          tpb.TreeState_ACTIVE,
+			TreeType:           tpb.TreeType_MAP,
+			HashStrategy:       tpb.HashStrategy_CONIKS_SHA256,
+			SignatureAlgorithm: sigpb.DigitallySigned_ECDSA,
+			HashAlgorithm:      sigpb.DigitallySigned_SHA256,
+		},
+		KeySpec: &keyspb.Specification{
+			Params: &keyspb.Specification_EcdsaParams{EcdsaParams: &keyspb.Specification_ECDSA{}},
+		},
+	}, ft.Admin(), ft.Map(), ft.Log())
+	if err != nil {
+		t.Fatalf("CreateAndInitTree(): %v", err)
+	}
+	leaf, err := (&entry.IndexedValue{Index: index[:], Value: got}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ft.MapWrite().WriteLeaves(ctx, &tpb.WriteMapLeavesRequest{
+		MapId:          tree.TreeId,
+		Leaves:         []*tpb.MapLeaf{leaf},
+		ExpectRevision: 1,
+	}); err != nil {
+		t.Fatalf("WriteLeaves(): %v", err)
+	}
+	resp, err := ft.Map().GetLeavesByRevision(ctx, &tpb.GetMapLeavesByRevisionRequest{
+		MapId:    tree.TreeId,
+		Index:    [][]byte{index[:]},
+		Revision: 1,
+	})
+	if err != nil {
+		t.Fatalf("GetLeavesByRevision(): %v", err)
+	}
+	var mapRoot types.MapRootV1
+	if err := mapRoot.UnmarshalBinary(resp.GetMapRoot().GetMapRoot()); err != nil {
+		t.Fatal(err)
+	}
+	inclusion := resp.GetMapLeafInclusion()[0]
+	var committed pb.Committed
+	if err := proto.Unmarshal(inclusion.GetLeaf().GetExtraData(), &committed); err != nil {
+		t.Fatal(err)
+	}
+
+	mv, err := tclient.NewMapVerifierFromTree(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := verifier.New(vrfPub, mv, nil, nil)
+	mapLeaf := &pb.MapLeaf{VrfProof: vrfProof, MapInclusion: inclusion, Committed: &committed}
+	if err := v.VerifyMapLeaf(directoryID, userID, mapLeaf, &mapRoot); err != nil {
+		t.Fatalf("VerifyMapLeaf(): %v", err)
+	}
+	if got, want := mapLeaf.GetCommitted().GetData(), []byte("owner data"); !bytes.Equal(got, want) {
+		t.Errorf("VerifyMapLeaf(): data %s, want %s", got, want)
+	}
+}
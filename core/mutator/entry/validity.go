@@ -0,0 +1,79 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+)
+
+// validityPeriod returns the not_before and not_after times of e.
+// Times that are not set are returned as the zero time.
+func validityPeriod(e *pb.Entry) (notBefore, notAfter time.Time, err error) {
+	if e.GetNotBefore() != nil {
+		if notBefore, err = ptypes.Timestamp(e.GetNotBefore()); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	if e.GetNotAfter() != nil {
+		if notAfter, err = ptypes.Timestamp(e.GetNotAfter()); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+	}
+	return notBefore, notAfter, nil
+}
+
+// checkValidityPeriod checks that the validity period of e is well formed.
+func checkValidityPeriod(e *pb.Entry) error {
+	notBefore, notAfter, err := validityPeriod(e)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "mutation: invalid validity period: %v", err)
+	}
+	if !notBefore.IsZero() && !notAfter.IsZero() && !notBefore.Before(notAfter) {
+		return status.Errorf(codes.InvalidArgument, "mutation: not_after %v is not after not_before %v", notAfter, notBefore)
+	}
+	return nil
+}
+
+// InValidityPeriod returns true if t is within the validity period of e.
+// Entries with a malformed validity period are never valid.
+func InValidityPeriod(e *pb.Entry, t time.Time) bool {
+	notBefore, notAfter, err := validityPeriod(e)
+	if err != nil {
+		return false
+	}
+	if !notBefore.IsZero() && t.Before(notBefore) {
+		return false
+	}
+	if !notAfter.IsZero() && t.After(notAfter) {
+		return false
+	}
+	return true
+}
+
+// NotAfter returns the time after which e is no longer valid, and false if e
+// does not expire.
+func NotAfter(e *pb.Entry) (time.Time, bool) {
+	_, notAfter, err := validityPeriod(e)
+	if err != nil || notAfter.IsZero() {
+		return time.Time{}, false
+	}
+	return notAfter, true
+}
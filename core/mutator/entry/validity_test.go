@@ -0,0 +1,98 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/google/keytransparency/core/testutil"
+
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	tpb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+)
+
+func TestInValidityPeriod(t *testing.T) {
+	start := time.Unix(1000, 0)
+	end := time.Unix(2000, 0)
+	ts := func(t time.Time) *tspb.Timestamp { return &tspb.Timestamp{Seconds: t.Unix()} }
+	for _, tc := range []struct {
+		desc  string
+		entry *tpb.Entry
+		t     time.Time
+		want  bool
+	}{
+		{desc: "no period", entry: &tpb.Entry{}, t: end, want: true},
+		{desc: "before not_before", entry: &tpb.Entry{NotBefore: ts(start)}, t: start.Add(-time.Second)},
+		{desc: "at not_before", entry: &tpb.Entry{NotBefore: ts(start)}, t: start, want: true},
+		{desc: "before not_after", entry: &tpb.Entry{NotAfter: ts(end)}, t: start, want: true},
+		{desc: "at not_after", entry: &tpb.Entry{NotAfter: ts(end)}, t: end, want: true},
+		{desc: "after not_after", entry: &tpb.Entry{NotAfter: ts(end)}, t: end.Add(time.Second)},
+		{desc: "within", entry: &tpb.Entry{NotBefore: ts(start), NotAfter: ts(end)}, t: start.Add(time.Second), want: true},
+		{desc: "malformed", entry: &tpb.Entry{NotAfter: &tspb.Timestamp{Nanos: -1}}, t: start},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := InValidityPeriod(tc.entry, tc.t); got != tc.want {
+				t.Errorf("InValidityPeriod(): %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValidityPeriod(t *testing.T) {
+	key := []byte{0}
+	start := time.Unix(1000, 0)
+	end := time.Unix(2000, 0)
+	for _, tc := range []struct {
+		desc      string
+		notBefore time.Time
+		notAfter  time.Time
+		deleted   bool
+		want      codes.Code
+	}{
+		{desc: "open"},
+		{desc: "not_after only", notAfter: end},
+		{desc: "both", notBefore: start, notAfter: end},
+		{desc: "reversed", notBefore: end, notAfter: start, want: codes.InvalidArgument},
+		{desc: "empty", notBefore: start, notAfter: start, want: codes.InvalidArgument},
+		{desc: "tombstone", notAfter: end, deleted: true, want: codes.InvalidArgument},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			m := NewMutation(key, "dir", "user")
+			if err := m.ReplaceAuthorizedKeys(testutil.VerifyKeysetFromPEMs(testPubKey1)); err != nil {
+				t.Fatalf("ReplaceAuthorizedKeys(): %v", err)
+			}
+			if tc.deleted {
+				m.Delete()
+			}
+			if err := m.SetValidityPeriod(tc.notBefore, tc.notAfter); err != nil {
+				t.Fatalf("SetValidityPeriod(): %v", err)
+			}
+			signed, err := m.sign(testutil.SignKeysetsFromPEMs(testPrivKey1))
+			if err != nil {
+				t.Fatalf("sign(): %v", err)
+			}
+			if err := IsValidEntry(signed); status.Code(err) != tc.want {
+				t.Errorf("IsValidEntry(): %v, want %v", err, tc.want)
+			}
+			if got, ok := NotAfter(m.entry); ok != !tc.notAfter.IsZero() || (ok && !got.Equal(tc.notAfter)) {
+				t.Errorf("NotAfter(): %v, %v, want %v", got, ok, tc.notAfter)
+			}
+		})
+	}
+}
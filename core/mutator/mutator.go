@@ -46,11 +46,36 @@ var (
 	// ErrUnauthorized occurs when the mutation has not been signed by a key in the
 	// previous entry.
 	ErrUnauthorized = status.Errorf(codes.PermissionDenied, "mutation: unauthorized")
+	// ErrRecoveryPending occurs when a mutation signed by the recovery keyset
+	// is resubmitted before its recovery delay has passed.
+	ErrRecoveryPending = status.Errorf(codes.FailedPrecondition, "mutation: recovery is pending")
+	// ErrImmutable occurs when a mutation tries to change an entry that the
+	// directory's mutator does not allow to change.
+	ErrImmutable = status.Errorf(codes.FailedPrecondition, "mutation: entry cannot be changed")
+	// ErrKeyRemoved occurs when a mutation removes a key from the authorized
+	// keyset of an entry in an append-only directory.
+	ErrKeyRemoved = status.Errorf(codes.FailedPrecondition, "mutation: authorized keys cannot be removed")
+	// ErrDeleted occurs when a mutation tries to replace a deleted entry in a
+	// directory that does not allow deleted entries to be registered again.
+	ErrDeleted = status.Errorf(codes.FailedPrecondition, "mutation: entry has been deleted")
 )
 
 // VerifyMutationFn verifies that a mutation is internally consistent.
 type VerifyMutationFn func(mutation *pb.SignedEntry) error
 
+// MutateFn verifies that newValue is a valid mutation for oldValue and returns
+// the application of newValue to oldValue in revision.
+type MutateFn func(oldValue, newValue *pb.SignedEntry, revision int64) (*pb.SignedEntry, error)
+
+// Mutator is a policy that decides which mutations a directory accepts and how
+// they change the entries in the directory.
+type Mutator struct {
+	// VerifyMutation is run by the key server before a mutation is queued.
+	VerifyMutation VerifyMutationFn
+	// Mutate is run by the sequencer and by monitors to apply a mutation.
+	Mutate MutateFn
+}
+
 // LogMessage represents a change to a user, and associated data.
 type LogMessage struct {
 	LogID     int64
@@ -0,0 +1,48 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sequencer
+
+import (
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+
+	"github.com/google/keytransparency/core/mutator/entry"
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	spb "github.com/google/keytransparency/core/sequencer/sequencer_go_proto"
+	tpb "github.com/google/trillian"
+)
+
+// leafExpiries returns the expiry of each leaf written in a new revision.
+// Leaves without a not_after time have an expiry without a not_after time,
+// which clears the expiry of the entry that they replace.
+func leafExpiries(leaves []*tpb.MapLeaf) ([]*spb.RevisionMetadata_Expiry, error) {
+	ret := make([]*spb.RevisionMetadata_Expiry, 0, len(leaves))
+	for _, l := range leaves {
+		signed, err := entry.FromLeafValue(l.GetLeafValue())
+		if err != nil {
+			return nil, err
+		}
+		var e pb.Entry
+		if err := proto.Unmarshal(signed.GetEntry(), &e); err != nil {
+			return nil, err
+		}
+		x := &spb.RevisionMetadata_Expiry{Index: l.GetIndex()}
+		if _, ok := entry.NotAfter(&e); ok {
+			x.NotAfter = e.GetNotAfter()
+		}
+		ret = append(ret, x)
+	}
+	return ret, nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sequencer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/golang/protobuf/ptypes"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/google/keytransparency/core/mutator/entry"
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	spb "github.com/google/keytransparency/core/sequencer/sequencer_go_proto"
+	tpb "github.com/google/trillian"
+)
+
+func TestLeafExpiries(t *testing.T) {
+	notAfter, err := ptypes.TimestampProto(time.Unix(2000, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf := func(index byte, e *pb.Entry) *tpb.MapLeaf {
+		e.Index = []byte{index}
+		entryData, err := proto.Marshal(e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		value, err := entry.ToLeafValue(&pb.SignedEntry{Entry: entryData})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &tpb.MapLeaf{Index: []byte{index}, LeafValue: value}
+	}
+
+	got, err := leafExpiries([]*tpb.MapLeaf{
+		leaf(1, &pb.Entry{NotAfter: notAfter}),
+		leaf(2, &pb.Entry{}),
+	})
+	if err != nil {
+		t.Fatalf("leafExpiries(): %v", err)
+	}
+	want := []*spb.RevisionMetadata_Expiry{
+		{Index: []byte{1}, NotAfter: notAfter},
+		{Index: []byte{2}}, // Clears any earlier expiry.
+	}
+	if !cmp.Equal(got, want, cmp.Comparer(proto.Equal)) {
+		t.Errorf("leafExpiries(): %v, want %v", got, want)
+	}
+}
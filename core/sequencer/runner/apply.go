@@ -0,0 +1,163 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runner
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/google/keytransparency/core/mutator"
+
+	tpb "github.com/google/trillian"
+)
+
+// NativeRunner is the name of the runner that executes the pipeline in this
+// process with the Do*Fn helpers.
+const NativeRunner = "native"
+
+var (
+	runnersMu sync.RWMutex
+	runners   = map[string]ApplyFn{NativeRunner: ApplyNative}
+)
+
+// ReadLeavesFn returns the current map leaves at indexes.
+type ReadLeavesFn func(ctx context.Context, indexes [][]byte) ([]*tpb.MapLeaf, error)
+
+// Fns are the transforms that turn log messages into new map leaves.
+type Fns struct {
+	MapLogItemFn MapLogItemFn
+	MapMapLeafFn MapMapLeafFn
+	ReduceFn     ReduceMutationFn
+	// Reduce describes ReduceFn with serializable values. Runners that
+	// execute the pipeline in other processes rebuild ReduceFn from it.
+	Reduce ReduceSpec
+}
+
+// ReduceSpec identifies the entry mutator that reduces a revision's mutations.
+type ReduceSpec struct {
+	// Mutator is the name of the directory's entry mutator.
+	Mutator string
+	// AllowReregistration lets new entries replace deleted entries.
+	AllowReregistration bool
+	// AdminKeyset is the serialized tink keyset that signs administrative
+	// overrides.
+	AdminKeyset []byte
+	// Revision is the map revision that the mutations are applied in.
+	Revision int64
+}
+
+// Output is the result of running the pipeline.
+type Output struct {
+	// Leaves are the new map leaves.
+	Leaves []*tpb.MapLeaf
+	// Mutations is the number of indexed mutations that were applied.
+	Mutations int
+	// Indexes is the number of distinct indexes that were mutated.
+	Indexes int
+}
+
+// ApplyFn computes the new map leaves produced by logItems. It maps logItems
+// into indexed mutations, reads the existing leaves at the mutated indexes
+// with readFn, joins them by index, and reduces each group into a new leaf.
+type ApplyFn func(ctx context.Context, fns *Fns, logItems []*mutator.LogMessage, readFn ReadLeavesFn,
+	emitErr func(error), incFn IncMetricFn) (*Output, error)
+
+// Register makes an ApplyFn available by name. Register panics if it is
+// called twice with the same name.
+func Register(name string, fn ApplyFn) {
+	runnersMu.Lock()
+	defer runnersMu.Unlock()
+	if _, ok := runners[name]; ok {
+		panic(fmt.Sprintf("runner: Register called twice for %q", name))
+	}
+	runners[name] = fn
+}
+
+// Runners returns the names of the registered runners.
+func Runners() []string {
+	runnersMu.RLock()
+	defer runnersMu.RUnlock()
+	return runnerNames()
+}
+
+// runnerNames returns the sorted names of the registered runners. The caller
+// must hold runnersMu.
+func runnerNames() []string {
+	names := make([]string, 0, len(runners))
+	for name := range runners {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns the ApplyFn registered as name. The empty name selects the
+// native runner.
+func Get(name string) (ApplyFn, error) {
+	if name == "" {
+		name = NativeRunner
+	}
+	runnersMu.RLock()
+	defer runnersMu.RUnlock()
+	fn, ok := runners[name]
+	if !ok {
+		return nil, fmt.Errorf("runner: unknown runner %q, want one of %v", name, runnerNames())
+	}
+	return fn, nil
+}
+
+// ApplyNative is an ApplyFn that runs the pipeline with the Do*Fn helpers.
+func ApplyNative(ctx context.Context, fns *Fns, logItems []*mutator.LogMessage, readFn ReadLeavesFn,
+	emitErr func(error), incFn IncMetricFn) (*Output, error) {
+	// Map Log Items
+	indexedValues := DoMapLogItemsFn(fns.MapLogItemFn, logItems, emitErr, incFn)
+
+	// Collect Indexes.
+	groupByIndex := make(map[string]bool)
+	for _, iv := range indexedValues {
+		groupByIndex[string(iv.Index)] = true
+	}
+	indexes := make([][]byte, 0, len(groupByIndex))
+	for i := range groupByIndex {
+		indexes = append(indexes, []byte(i))
+	}
+
+	// Read Map.
+	leaves, err := readFn(ctx, indexes)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert Trillian map leaves into indexed KT updates.
+	indexedLeaves, err := DoMapMapLeafFn(fns.MapMapLeafFn, leaves, incFn)
+	if err != nil {
+		return nil, err
+	}
+
+	// GroupByIndex.
+	joined := Join(indexedLeaves, indexedValues, incFn)
+
+	// Apply mutations to values.
+	newIndexedLeaves := DoReduceFn(fns.ReduceFn, joined, emitErr, incFn)
+
+	// Marshal new indexed values back into Trillian Map leaves.
+	return &Output{
+		Leaves:    DoMarshalIndexedValues(newIndexedLeaves, emitErr, incFn),
+		Mutations: len(indexedValues),
+		Indexes:   len(indexes),
+	}, nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runner
+
+import (
+	"testing"
+)
+
+func TestGet(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		wantErr bool
+	}{
+		{name: ""},
+		{name: NativeRunner},
+		{name: "unknown", wantErr: true},
+	} {
+		if _, err := Get(tc.name); (err != nil) != tc.wantErr {
+			t.Errorf("Get(%q): %v, wantErr %v", tc.name, err, tc.wantErr)
+		}
+	}
+}
+
+func TestRegister(t *testing.T) {
+	Register("TestRegister", ApplyNative)
+	if _, err := Get("TestRegister"); err != nil {
+		t.Errorf("Get(): %v", err)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Register() twice did not panic")
+		}
+	}()
+	Register("TestRegister", ApplyNative)
+}
@@ -0,0 +1,394 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build beam
+// +build beam
+
+// Package beamrunner runs the map revision pipeline with the Apache Beam Go
+// SDK on the local direct runner. It registers itself with package runner as
+// "beam". The Beam SDK is an optional dependency, so this package is only
+// built with the beam build tag.
+//
+// The pipeline only holds serialized values and registered DoFns, so that
+// its transforms can be executed by workers in other processes.
+package beamrunner
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/apache/beam/sdks/go/pkg/beam"
+	"github.com/apache/beam/sdks/go/pkg/beam/io/textio"
+	"github.com/apache/beam/sdks/go/pkg/beam/runners/direct"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/keytransparency/core/mutator"
+	"github.com/google/keytransparency/core/mutator/entry"
+	"github.com/google/keytransparency/core/sequencer/mapper"
+	"github.com/google/keytransparency/core/sequencer/runner"
+	"github.com/google/keytransparency/core/water"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	_ "github.com/apache/beam/sdks/go/pkg/beam/io/filesystem/local" // Register the local filesystem for textio.
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	tpb "github.com/google/trillian"
+	rpcpb "google.golang.org/genproto/googleapis/rpc/status"
+)
+
+// Name is the name the Beam runner is registered under.
+const Name = "beam"
+
+func init() {
+	beam.RegisterType(reflect.TypeOf((*logMessage)(nil)).Elem())
+	beam.RegisterType(reflect.TypeOf((*reduceFn)(nil)).Elem())
+	beam.RegisterFunction(mapLogItemFn)
+	beam.RegisterFunction(mapMapLeafFn)
+	runner.Register(Name, Apply)
+}
+
+// logMessage is a mutator.LogMessage with its protos serialized, so that Beam
+// can encode it.
+type logMessage struct {
+	LogID     int64
+	ID        uint64
+	LocalID   int64
+	CreatedAt int64 // Unix nanoseconds.
+	Mutation  []byte
+	ExtraData []byte
+}
+
+func newLogMessage(m *mutator.LogMessage) (logMessage, error) {
+	mutation, err := proto.Marshal(m.Mutation)
+	if err != nil {
+		return logMessage{}, err
+	}
+	extraData, err := proto.Marshal(m.ExtraData)
+	if err != nil {
+		return logMessage{}, err
+	}
+	return logMessage{
+		LogID:     m.LogID,
+		ID:        m.ID.Value(),
+		LocalID:   m.LocalID,
+		CreatedAt: m.CreatedAt.UnixNano(),
+		Mutation:  mutation,
+		ExtraData: extraData,
+	}, nil
+}
+
+func (m logMessage) decode() (*mutator.LogMessage, error) {
+	var mutation pb.SignedEntry
+	if err := proto.Unmarshal(m.Mutation, &mutation); err != nil {
+		return nil, err
+	}
+	var extraData pb.Committed
+	if err := proto.Unmarshal(m.ExtraData, &extraData); err != nil {
+		return nil, err
+	}
+	return &mutator.LogMessage{
+		LogID:     m.LogID,
+		ID:        water.NewMark(m.ID),
+		LocalID:   m.LocalID,
+		CreatedAt: time.Unix(0, m.CreatedAt),
+		Mutation:  &mutation,
+		ExtraData: &extraData,
+	}, nil
+}
+
+// errorEmitter emits the errors of a transform as base64 encoded
+// google.rpc.Status protos, so that they can be written as lines of text.
+type errorEmitter struct {
+	emit func(string)
+	err  error // The first error that could not be encoded.
+}
+
+func (e *errorEmitter) wrap(msg string) func(error) {
+	return func(err error) {
+		s := status.Convert(err)
+		b, err := proto.Marshal(status.New(s.Code(), fmt.Sprintf("%v: %v", msg, s.Message())).Proto())
+		if err != nil {
+			if e.err == nil {
+				e.err = err
+			}
+			return
+		}
+		e.emit(base64.StdEncoding.EncodeToString(b))
+	}
+}
+
+// mapLogItemFn maps a log message into KV<index, EntryUpdate>.
+func mapLogItemFn(m logMessage, emit func(string, []byte), emitErr func(string)) error {
+	errs := &errorEmitter{emit: emitErr}
+	logItem, err := m.decode()
+	if err != nil {
+		errs.wrap("mapLogItemFn")(err)
+		return errs.err
+	}
+	entry.MapLogItemFn(logItem,
+		func(index []byte, value *pb.EntryUpdate) {
+			b, err := proto.Marshal(value)
+			if err != nil {
+				errs.wrap("mapLogItemFn")(status.Errorf(codes.Internal, "proto.Marshal(): %v", err))
+				return
+			}
+			emit(string(index), b)
+		},
+		errs.wrap("mapLogItemFn"),
+	)
+	return errs.err
+}
+
+// mapMapLeafFn maps a serialized map leaf into KV<index, EntryUpdate>.
+func mapMapLeafFn(b []byte, emit func(string, []byte)) error {
+	var leaf tpb.MapLeaf
+	if err := proto.Unmarshal(b, &leaf); err != nil {
+		return err
+	}
+	iv, err := mapper.MapMapLeafFn(&leaf)
+	if err != nil {
+		return err
+	}
+	value, err := proto.Marshal(iv.Value)
+	if err != nil {
+		return err
+	}
+	emit(string(iv.Index), value)
+	return nil
+}
+
+// reduceFn applies the mutations at an index to its existing leaf and emits
+// the new leaf as a base64 encoded MapLeaf. Its fields are those of a
+// runner.ReduceSpec; the mutator is rebuilt from them by each worker.
+type reduceFn struct {
+	Mutator             string `json:"mutator"`
+	AllowReregistration bool   `json:"allow_reregistration"`
+	AdminKeyset         []byte `json:"admin_keyset"`
+	Revision            int64  `json:"revision"`
+
+	fn runner.ReduceMutationFn
+}
+
+func (f *reduceFn) Setup() error {
+	mut, err := entry.GetMutator(f.Mutator, entry.Options{
+		AllowReregistration: f.AllowReregistration,
+		AdminKeyset:         f.AdminKeyset,
+	})
+	if err != nil {
+		return err
+	}
+	f.fn = entry.NewReduceFn(mut.Mutate, f.Revision)
+	return nil
+}
+
+func (f *reduceFn) ProcessElement(index string, leafIter, msgIter func(*[]byte) bool,
+	emit func(string), emitErr func(string)) error {
+	leaves, err := decodeUpdates(leafIter)
+	if err != nil {
+		return err
+	}
+	msgs, err := decodeUpdates(msgIter)
+	if err != nil {
+		return err
+	}
+	errs := &errorEmitter{emit: emitErr}
+	f.fn(leaves, msgs,
+		func(e *pb.EntryUpdate) {
+			// Marshal new indexed values back into Trillian Map leaves.
+			leaf, err := (&entry.IndexedValue{Index: []byte(index), Value: e}).Marshal()
+			if err != nil {
+				errs.wrap("MarshalIndexedValue()")(status.Errorf(codes.Internal, "%v", err))
+				return
+			}
+			b, err := proto.Marshal(leaf)
+			if err != nil {
+				errs.wrap("proto.Marshal()")(status.Errorf(codes.Internal, "%v", err))
+				return
+			}
+			emit(base64.StdEncoding.EncodeToString(b))
+		},
+		errs.wrap(fmt.Sprintf("reduceFn on index %x", index)),
+	)
+	return errs.err
+}
+
+// decodeUpdates reads the serialized EntryUpdates produced by iter.
+func decodeUpdates(iter func(*[]byte) bool) ([]*pb.EntryUpdate, error) {
+	var updates []*pb.EntryUpdate
+	var b []byte
+	for iter(&b) {
+		var u pb.EntryUpdate
+		if err := proto.Unmarshal(b, &u); err != nil {
+			return nil, err
+		}
+		updates = append(updates, &u)
+	}
+	return updates, nil
+}
+
+// buildPipeline adds the transforms that apply msgs, a PCollection<logMessage>,
+// to leaves, a PCollection<[]byte> of serialized map leaves, to s. It returns
+// the new leaves and the errors of the mutations that were rejected as
+// PCollection<string>s of base64 encoded MapLeaf and google.rpc.Status protos.
+func buildPipeline(s beam.Scope, spec runner.ReduceSpec, msgs, leaves beam.PCollection) (newLeaves, errs beam.PCollection) {
+	// Map Log Items into KV<index, EntryUpdate>.
+	mutations, mapErrs := beam.ParDo2(s, mapLogItemFn, msgs)
+
+	// Convert Trillian map leaves into KV<index, EntryUpdate>.
+	indexedLeaves := beam.ParDo(s, mapMapLeafFn, leaves)
+
+	// GroupByIndex and apply mutations to values.
+	joined := beam.CoGroupByKey(s, indexedLeaves, mutations)
+	newLeaves, reduceErrs := beam.ParDo2(s, &reduceFn{
+		Mutator:             spec.Mutator,
+		AllowReregistration: spec.AllowReregistration,
+		AdminKeyset:         spec.AdminKeyset,
+		Revision:            spec.Revision,
+	}, joined)
+	return newLeaves, beam.Flatten(s, mapErrs, reduceErrs)
+}
+
+// Apply is a runner.ApplyFn that executes the pipeline described by
+// fns.Reduce with Beam. The function values in fns cannot be sent to
+// workers, so the pipeline always uses the entry and mapper transforms.
+//
+// readFn cannot be sent to workers either, so Apply reads the existing leaves
+// before it runs the pipeline. The pipeline writes the new leaves and errors
+// to files, which Apply reads back once it completes.
+func Apply(ctx context.Context, fns *runner.Fns, logItems []*mutator.LogMessage, readFn runner.ReadLeavesFn,
+	emitErr func(error), incFn runner.IncMetricFn) (*runner.Output, error) {
+	if len(logItems) == 0 {
+		return &runner.Output{}, nil
+	}
+	msgs := make([]logMessage, 0, len(logItems))
+	for _, m := range logItems {
+		msg, err := newLogMessage(m)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "newLogMessage(): %v", err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	// Read Map. Errors in the log items are reported by the pipeline.
+	out := &runner.Output{}
+	groupByIndex := make(map[string]bool)
+	for _, m := range logItems {
+		entry.MapLogItemFn(m, func(index []byte, _ *pb.EntryUpdate) {
+			out.Mutations++
+			groupByIndex[string(index)] = true
+		}, func(error) {})
+	}
+	indexes := make([][]byte, 0, len(groupByIndex))
+	for i := range groupByIndex {
+		indexes = append(indexes, []byte(i))
+	}
+	out.Indexes = len(indexes)
+	leaves, err := readFn(ctx, indexes)
+	if err != nil {
+		return nil, err
+	}
+	serializedLeaves := make([][]byte, 0, len(leaves))
+	for _, l := range leaves {
+		b, err := proto.Marshal(l)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "proto.Marshal(): %v", err)
+		}
+		serializedLeaves = append(serializedLeaves, b)
+	}
+
+	dir, err := ioutil.TempDir("", "beamrunner")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "ioutil.TempDir(): %v", err)
+	}
+	defer os.RemoveAll(dir)
+	leavesFile := filepath.Join(dir, "leaves")
+	errsFile := filepath.Join(dir, "errors")
+
+	p, s := beam.NewPipelineWithRoot()
+	newLeaves, errs := buildPipeline(s, fns.Reduce,
+		beam.CreateList(s, msgs), beam.CreateList(s, serializedLeaves))
+	textio.Write(s, leavesFile, newLeaves)
+	textio.Write(s, errsFile, errs)
+	if _, err := direct.Execute(ctx, p); err != nil {
+		return nil, status.Errorf(codes.Internal, "beam pipeline: %v", err)
+	}
+
+	if err := readLines(errsFile, func(b []byte) error {
+		var s rpcpb.Status
+		if err := proto.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		emitErr(status.ErrorProto(&s))
+		return nil
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "reading errors: %v", err)
+	}
+	if err := readLines(leavesFile, func(b []byte) error {
+		var leaf tpb.MapLeaf
+		if err := proto.Unmarshal(b, &leaf); err != nil {
+			return err
+		}
+		out.Leaves = append(out.Leaves, &leaf)
+		return nil
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "reading leaves: %v", err)
+	}
+
+	// The direct runner does not report Beam metrics, so count the
+	// elements of each transform from its inputs and outputs.
+	for label, n := range map[string]int{
+		"MapLogItemFn":        len(logItems),
+		"MapMapLeafFn":        len(leaves),
+		"Join1":               len(leaves),
+		"Join2":               out.Mutations,
+		"ReduceFn":            out.Indexes,
+		"MarshalIndexedValue": len(out.Leaves),
+	} {
+		for i := 0; i < n; i++ {
+			incFn(label)
+		}
+	}
+	return out, nil
+}
+
+// readLines calls fn on the base64 decoded lines of file. textio does not
+// create a file for an empty PCollection, so a missing file has no lines.
+func readLines(file string, fn func([]byte) error) error {
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 64<<20)
+	for scanner.Scan() {
+		b, err := base64.StdEncoding.DecodeString(scanner.Text())
+		if err != nil {
+			return err
+		}
+		if err := fn(b); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
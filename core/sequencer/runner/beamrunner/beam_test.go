@@ -0,0 +1,35 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build beam
+// +build beam
+
+package beamrunner
+
+import (
+	"testing"
+
+	"github.com/google/keytransparency/core/sequencer/runner"
+	"github.com/google/keytransparency/core/sequencer/runner/runnertest"
+)
+
+func TestApply(t *testing.T) {
+	runnertest.RunApplyFnTest(t, Apply)
+}
+
+func TestRegistered(t *testing.T) {
+	if _, err := runner.Get(Name); err != nil {
+		t.Errorf("runner.Get(%q): %v", Name, err)
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package runnertest verifies that runner.ApplyFn implementations compute the
+// same map leaves.
+package runnertest
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/keytransparency/core/mutator"
+	"github.com/google/keytransparency/core/mutator/entry"
+	"github.com/google/keytransparency/core/sequencer/mapper"
+	"github.com/google/keytransparency/core/sequencer/runner"
+	"github.com/google/tink/go/keyset"
+	"github.com/google/tink/go/signature"
+	"github.com/google/tink/go/tink"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	tpb "github.com/google/trillian"
+)
+
+const revision = 1
+
+// newKeys returns a new signing key and its public keyset.
+func newKeys(t *testing.T) ([]tink.Signer, *keyset.Handle) {
+	t.Helper()
+	handle, err := keyset.NewHandle(signature.ECDSAP256KeyTemplate())
+	if err != nil {
+		t.Fatalf("keyset.NewHandle(): %v", err)
+	}
+	pub, err := handle.Public()
+	if err != nil {
+		t.Fatalf("Public(): %v", err)
+	}
+	signer, err := signature.NewSigner(handle)
+	if err != nil {
+		t.Fatalf("signature.NewSigner(): %v", err)
+	}
+	return []tink.Signer{signer}, pub
+}
+
+// RunApplyFnTest checks that apply computes the expected leaves with the
+// default entry mutator.
+func RunApplyFnTest(t *testing.T, apply runner.ApplyFn) {
+	t.Helper()
+	ctx := context.Background()
+	signers1, keys1 := newKeys(t)
+	signers2, keys2 := newKeys(t)
+
+	// update signs a mutation of index that commits to data. If prev is
+	// set, the mutation keeps the keys of prev.
+	update := func(index, data string, prev *tpb.MapLeaf, keys *keyset.Handle, signers []tink.Signer) *pb.EntryUpdate {
+		t.Helper()
+		m := entry.NewMutation([]byte(index), "directory", "user")
+		if prev != nil {
+			if err := m.SetPrevious(0, prev.LeafValue, true); err != nil {
+				t.Fatalf("SetPrevious(): %v", err)
+			}
+		} else if err := m.ReplaceAuthorizedKeys(keys); err != nil {
+			t.Fatalf("ReplaceAuthorizedKeys(): %v", err)
+		}
+		if err := m.SetCommitment([]byte(data)); err != nil {
+			t.Fatalf("SetCommitment(): %v", err)
+		}
+		u, err := m.SerializeAndSign(signers)
+		if err != nil {
+			t.Fatalf("SerializeAndSign(): %v", err)
+		}
+		return u
+	}
+	logItem := func(u *pb.EntryUpdate) *mutator.LogMessage {
+		return &mutator.LogMessage{Mutation: u.Mutation, ExtraData: u.Committed}
+	}
+	existing, err := (&entry.IndexedValue{
+		Index: []byte("A"),
+		Value: update("A", "old", nil, keys1, signers1),
+	}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	readFn := func(_ context.Context, indexes [][]byte) ([]*tpb.MapLeaf, error) {
+		var leaves []*tpb.MapLeaf
+		for _, i := range indexes {
+			if bytes.Equal(i, existing.Index) {
+				leaves = append(leaves, existing)
+			}
+		}
+		return leaves, nil
+	}
+	mut, err := entry.GetMutator(entry.DefaultMutator, entry.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	fns := &runner.Fns{
+		MapLogItemFn: entry.MapLogItemFn,
+		MapMapLeafFn: mapper.MapMapLeafFn,
+		ReduceFn:     entry.NewReduceFn(mut.Mutate, revision),
+		Reduce:       runner.ReduceSpec{Mutator: entry.DefaultMutator, Revision: revision},
+	}
+	logItems := []*mutator.LogMessage{
+		logItem(update("A", "new", existing, nil, signers1)),
+		logItem(update("B", "b", nil, keys1, signers1)),
+		// Not signed by the keys of the existing leaf.
+		logItem(update("A", "stolen", nil, keys2, signers2)),
+	}
+
+	var mu sync.Mutex
+	var errs []error
+	emitErr := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+	}
+	out, err := apply(ctx, fns, logItems, readFn, emitErr, func(string) {})
+	if err != nil {
+		t.Fatalf("apply(): %v", err)
+	}
+	if got, want := out.Mutations, 3; got != want {
+		t.Errorf("Mutations: %v, want %v", got, want)
+	}
+	if got, want := out.Indexes, 2; got != want {
+		t.Errorf("Indexes: %v, want %v", got, want)
+	}
+	if len(errs) != 1 || status.Code(errs[0]) != codes.PermissionDenied {
+		t.Errorf("emitErr: %v, want one %v error", errs, codes.PermissionDenied)
+	}
+	got := make(map[string]string)
+	for _, l := range out.Leaves {
+		var c pb.Committed
+		if err := proto.Unmarshal(l.ExtraData, &c); err != nil {
+			t.Fatal(err)
+		}
+		got[string(l.Index)] = string(c.Data)
+	}
+	want := map[string]string{"A": "new", "B": "b"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("leaves: %v, want %v\n diff: %v", got, want, cmp.Diff(got, want))
+	}
+
+	// Empty revisions have no leaves to write.
+	out, err = apply(ctx, fns, nil, readFn, func(err error) { t.Error(err) }, func(string) {})
+	if err != nil {
+		t.Fatalf("apply(empty): %v", err)
+	}
+	if got := len(out.Leaves); got != 0 {
+		t.Errorf("apply(empty): %v leaves, want 0", got)
+	}
+}
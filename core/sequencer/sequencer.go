@@ -123,14 +123,15 @@ func (s *Sequencer) ForAllMasterships(ctx context.Context, f func(ctx context.Co
 
 // DefineRevisionsForAllMasterships runs KeyTransparencySequencerClient's
 // DefineRevisions method on all directories that this sequencer is currently
-// master for.
-func (s *Sequencer) DefineRevisionsForAllMasterships(ctx context.Context, batchSize int32) error {
+// master for. A revision with fewer than minBatch mutations is only defined
+// once the directory's MinInterval has passed.
+func (s *Sequencer) DefineRevisionsForAllMasterships(ctx context.Context, minBatch, maxBatch int32) error {
 	return s.ForAllMasterships(ctx, func(ctx context.Context, dirID string) error {
-		// TODO(pavelkalinnikov): Make these parameters configurable.
+		// TODO(pavelkalinnikov): Make MaxUnapplied configurable.
 		req := &spb.DefineRevisionsRequest{
 			DirectoryId:  dirID,
-			MinBatch:     1,
-			MaxBatch:     batchSize,
+			MinBatch:     minBatch,
+			MaxBatch:     maxBatch,
 			MaxUnapplied: 1,
 		}
 		if _, err := s.sequencerClient.DefineRevisions(ctx, req); err != nil {
@@ -29,6 +29,7 @@ import (
 	context "context"
 	proto "github.com/golang/protobuf/proto"
 	empty "github.com/golang/protobuf/ptypes/empty"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -97,6 +98,66 @@ func (x *MapMetadata) GetSources() []*MapMetadata_SourceSlice {
 	return nil
 }
 
+// RevisionMetadata is stored in the metadata of each map root.
+type RevisionMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// expired lists the entries whose not_after time is in
+	// [previous sweep_time, sweep_time), and which were not replaced by an entry
+	// with a later not_after time.
+	Expired []*RevisionMetadata_Expiry `protobuf:"bytes,2,rep,name=expired,proto3" json:"expired,omitempty"`
+	// sweep_time is the time up to which not_after times have been swept.
+	SweepTime *timestamp.Timestamp `protobuf:"bytes,3,opt,name=sweep_time,json=sweepTime,proto3" json:"sweep_time,omitempty"`
+}
+
+func (x *RevisionMetadata) Reset() {
+	*x = RevisionMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sequencer_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevisionMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevisionMetadata) ProtoMessage() {}
+
+func (x *RevisionMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_sequencer_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevisionMetadata.ProtoReflect.Descriptor instead.
+func (*RevisionMetadata) Descriptor() ([]byte, []int) {
+	return file_sequencer_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RevisionMetadata) GetExpired() []*RevisionMetadata_Expiry {
+	if x != nil {
+		return x.Expired
+	}
+	return nil
+}
+
+func (x *RevisionMetadata) GetSweepTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.SweepTime
+	}
+	return nil
+}
+
 // DefineRevisionsRequest contains information needed to define new revisions.
 type DefineRevisionsRequest struct {
 	state         protoimpl.MessageState
@@ -106,9 +167,8 @@ type DefineRevisionsRequest struct {
 	// directory_id is the directory to examine the outstanding mutations for.
 	DirectoryId string `protobuf:"bytes,1,opt,name=directory_id,json=directoryId,proto3" json:"directory_id,omitempty"`
 	// min_batch is the minimum number of items in a batch.
-	// If less than min_batch items are available, nothing happens.
-	// TODO(#1047): Replace with timeout so items in the log get processed
-	// eventually.
+	// If less than min_batch items are available, nothing happens until the
+	// directory's min_interval has passed since the latest revision.
 	MinBatch int32 `protobuf:"varint,2,opt,name=min_batch,json=minBatch,proto3" json:"min_batch,omitempty"`
 	// max_batch is the maximum number of items in a batch.
 	MaxBatch int32 `protobuf:"varint,3,opt,name=max_batch,json=maxBatch,proto3" json:"max_batch,omitempty"`
@@ -120,7 +180,7 @@ type DefineRevisionsRequest struct {
 func (x *DefineRevisionsRequest) Reset() {
 	*x = DefineRevisionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sequencer_api_proto_msgTypes[1]
+		mi := &file_sequencer_api_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -133,7 +193,7 @@ func (x *DefineRevisionsRequest) String() string {
 func (*DefineRevisionsRequest) ProtoMessage() {}
 
 func (x *DefineRevisionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sequencer_api_proto_msgTypes[1]
+	mi := &file_sequencer_api_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -146,7 +206,7 @@ func (x *DefineRevisionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefineRevisionsRequest.ProtoReflect.Descriptor instead.
 func (*DefineRevisionsRequest) Descriptor() ([]byte, []int) {
-	return file_sequencer_api_proto_rawDescGZIP(), []int{1}
+	return file_sequencer_api_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *DefineRevisionsRequest) GetDirectoryId() string {
@@ -193,7 +253,7 @@ type DefineRevisionsResponse struct {
 func (x *DefineRevisionsResponse) Reset() {
 	*x = DefineRevisionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sequencer_api_proto_msgTypes[2]
+		mi := &file_sequencer_api_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -206,7 +266,7 @@ func (x *DefineRevisionsResponse) String() string {
 func (*DefineRevisionsResponse) ProtoMessage() {}
 
 func (x *DefineRevisionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sequencer_api_proto_msgTypes[2]
+	mi := &file_sequencer_api_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +279,7 @@ func (x *DefineRevisionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DefineRevisionsResponse.ProtoReflect.Descriptor instead.
 func (*DefineRevisionsResponse) Descriptor() ([]byte, []int) {
-	return file_sequencer_api_proto_rawDescGZIP(), []int{2}
+	return file_sequencer_api_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *DefineRevisionsResponse) GetHighestApplied() int64 {
@@ -250,7 +310,7 @@ type GetDefinedRevisionsRequest struct {
 func (x *GetDefinedRevisionsRequest) Reset() {
 	*x = GetDefinedRevisionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sequencer_api_proto_msgTypes[3]
+		mi := &file_sequencer_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -263,7 +323,7 @@ func (x *GetDefinedRevisionsRequest) String() string {
 func (*GetDefinedRevisionsRequest) ProtoMessage() {}
 
 func (x *GetDefinedRevisionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sequencer_api_proto_msgTypes[3]
+	mi := &file_sequencer_api_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -276,7 +336,7 @@ func (x *GetDefinedRevisionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDefinedRevisionsRequest.ProtoReflect.Descriptor instead.
 func (*GetDefinedRevisionsRequest) Descriptor() ([]byte, []int) {
-	return file_sequencer_api_proto_rawDescGZIP(), []int{3}
+	return file_sequencer_api_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetDefinedRevisionsRequest) GetDirectoryId() string {
@@ -302,7 +362,7 @@ type GetDefinedRevisionsResponse struct {
 func (x *GetDefinedRevisionsResponse) Reset() {
 	*x = GetDefinedRevisionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sequencer_api_proto_msgTypes[4]
+		mi := &file_sequencer_api_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -315,7 +375,7 @@ func (x *GetDefinedRevisionsResponse) String() string {
 func (*GetDefinedRevisionsResponse) ProtoMessage() {}
 
 func (x *GetDefinedRevisionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sequencer_api_proto_msgTypes[4]
+	mi := &file_sequencer_api_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -328,7 +388,7 @@ func (x *GetDefinedRevisionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDefinedRevisionsResponse.ProtoReflect.Descriptor instead.
 func (*GetDefinedRevisionsResponse) Descriptor() ([]byte, []int) {
-	return file_sequencer_api_proto_rawDescGZIP(), []int{4}
+	return file_sequencer_api_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetDefinedRevisionsResponse) GetHighestApplied() int64 {
@@ -358,7 +418,7 @@ type ApplyRevisionsRequest struct {
 func (x *ApplyRevisionsRequest) Reset() {
 	*x = ApplyRevisionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sequencer_api_proto_msgTypes[5]
+		mi := &file_sequencer_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -371,7 +431,7 @@ func (x *ApplyRevisionsRequest) String() string {
 func (*ApplyRevisionsRequest) ProtoMessage() {}
 
 func (x *ApplyRevisionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sequencer_api_proto_msgTypes[5]
+	mi := &file_sequencer_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,7 +444,7 @@ func (x *ApplyRevisionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyRevisionsRequest.ProtoReflect.Descriptor instead.
 func (*ApplyRevisionsRequest) Descriptor() ([]byte, []int) {
-	return file_sequencer_api_proto_rawDescGZIP(), []int{5}
+	return file_sequencer_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ApplyRevisionsRequest) GetDirectoryId() string {
@@ -409,7 +469,7 @@ type ApplyRevisionRequest struct {
 func (x *ApplyRevisionRequest) Reset() {
 	*x = ApplyRevisionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sequencer_api_proto_msgTypes[6]
+		mi := &file_sequencer_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -422,7 +482,7 @@ func (x *ApplyRevisionRequest) String() string {
 func (*ApplyRevisionRequest) ProtoMessage() {}
 
 func (x *ApplyRevisionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sequencer_api_proto_msgTypes[6]
+	mi := &file_sequencer_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +495,7 @@ func (x *ApplyRevisionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyRevisionRequest.ProtoReflect.Descriptor instead.
 func (*ApplyRevisionRequest) Descriptor() ([]byte, []int) {
-	return file_sequencer_api_proto_rawDescGZIP(), []int{6}
+	return file_sequencer_api_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ApplyRevisionRequest) GetDirectoryId() string {
@@ -470,7 +530,7 @@ type ApplyRevisionResponse struct {
 func (x *ApplyRevisionResponse) Reset() {
 	*x = ApplyRevisionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sequencer_api_proto_msgTypes[7]
+		mi := &file_sequencer_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -483,7 +543,7 @@ func (x *ApplyRevisionResponse) String() string {
 func (*ApplyRevisionResponse) ProtoMessage() {}
 
 func (x *ApplyRevisionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sequencer_api_proto_msgTypes[7]
+	mi := &file_sequencer_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,7 +556,7 @@ func (x *ApplyRevisionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyRevisionResponse.ProtoReflect.Descriptor instead.
 func (*ApplyRevisionResponse) Descriptor() ([]byte, []int) {
-	return file_sequencer_api_proto_rawDescGZIP(), []int{7}
+	return file_sequencer_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ApplyRevisionResponse) GetDirectoryId() string {
@@ -541,7 +601,7 @@ type PublishRevisionsRequest struct {
 func (x *PublishRevisionsRequest) Reset() {
 	*x = PublishRevisionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sequencer_api_proto_msgTypes[8]
+		mi := &file_sequencer_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -554,7 +614,7 @@ func (x *PublishRevisionsRequest) String() string {
 func (*PublishRevisionsRequest) ProtoMessage() {}
 
 func (x *PublishRevisionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sequencer_api_proto_msgTypes[8]
+	mi := &file_sequencer_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +627,7 @@ func (x *PublishRevisionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishRevisionsRequest.ProtoReflect.Descriptor instead.
 func (*PublishRevisionsRequest) Descriptor() ([]byte, []int) {
-	return file_sequencer_api_proto_rawDescGZIP(), []int{8}
+	return file_sequencer_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PublishRevisionsRequest) GetDirectoryId() string {
@@ -597,7 +657,7 @@ type PublishRevisionsResponse struct {
 func (x *PublishRevisionsResponse) Reset() {
 	*x = PublishRevisionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sequencer_api_proto_msgTypes[9]
+		mi := &file_sequencer_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -610,7 +670,7 @@ func (x *PublishRevisionsResponse) String() string {
 func (*PublishRevisionsResponse) ProtoMessage() {}
 
 func (x *PublishRevisionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sequencer_api_proto_msgTypes[9]
+	mi := &file_sequencer_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -623,7 +683,7 @@ func (x *PublishRevisionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PublishRevisionsResponse.ProtoReflect.Descriptor instead.
 func (*PublishRevisionsResponse) Descriptor() ([]byte, []int) {
-	return file_sequencer_api_proto_rawDescGZIP(), []int{9}
+	return file_sequencer_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PublishRevisionsResponse) GetRevisions() []int64 {
@@ -645,7 +705,7 @@ type EstimateBacklogRequest struct {
 func (x *EstimateBacklogRequest) Reset() {
 	*x = EstimateBacklogRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sequencer_api_proto_msgTypes[10]
+		mi := &file_sequencer_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -658,7 +718,7 @@ func (x *EstimateBacklogRequest) String() string {
 func (*EstimateBacklogRequest) ProtoMessage() {}
 
 func (x *EstimateBacklogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sequencer_api_proto_msgTypes[10]
+	mi := &file_sequencer_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,7 +731,7 @@ func (x *EstimateBacklogRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EstimateBacklogRequest.ProtoReflect.Descriptor instead.
 func (*EstimateBacklogRequest) Descriptor() ([]byte, []int) {
-	return file_sequencer_api_proto_rawDescGZIP(), []int{10}
+	return file_sequencer_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *EstimateBacklogRequest) GetDirectoryId() string {
@@ -700,7 +760,7 @@ type EstimateBacklogResponse struct {
 func (x *EstimateBacklogResponse) Reset() {
 	*x = EstimateBacklogResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sequencer_api_proto_msgTypes[11]
+		mi := &file_sequencer_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -713,7 +773,7 @@ func (x *EstimateBacklogResponse) String() string {
 func (*EstimateBacklogResponse) ProtoMessage() {}
 
 func (x *EstimateBacklogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sequencer_api_proto_msgTypes[11]
+	mi := &file_sequencer_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +786,7 @@ func (x *EstimateBacklogResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EstimateBacklogResponse.ProtoReflect.Descriptor instead.
 func (*EstimateBacklogResponse) Descriptor() ([]byte, []int) {
-	return file_sequencer_api_proto_rawDescGZIP(), []int{11}
+	return file_sequencer_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EstimateBacklogResponse) GetDirectoryId() string {
@@ -767,7 +827,7 @@ type MapMetadata_SourceSlice struct {
 func (x *MapMetadata_SourceSlice) Reset() {
 	*x = MapMetadata_SourceSlice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sequencer_api_proto_msgTypes[12]
+		mi := &file_sequencer_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -780,7 +840,7 @@ func (x *MapMetadata_SourceSlice) String() string {
 func (*MapMetadata_SourceSlice) ProtoMessage() {}
 
 func (x *MapMetadata_SourceSlice) ProtoReflect() protoreflect.Message {
-	mi := &file_sequencer_api_proto_msgTypes[12]
+	mi := &file_sequencer_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,6 +877,63 @@ func (x *MapMetadata_SourceSlice) GetLogId() int64 {
 	return 0
 }
 
+// Expiry records the time after which the entry at index is no longer
+// valid.
+type RevisionMetadata_Expiry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index    []byte               `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	NotAfter *timestamp.Timestamp `protobuf:"bytes,2,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+}
+
+func (x *RevisionMetadata_Expiry) Reset() {
+	*x = RevisionMetadata_Expiry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sequencer_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevisionMetadata_Expiry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevisionMetadata_Expiry) ProtoMessage() {}
+
+func (x *RevisionMetadata_Expiry) ProtoReflect() protoreflect.Message {
+	mi := &file_sequencer_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevisionMetadata_Expiry.ProtoReflect.Descriptor instead.
+func (*RevisionMetadata_Expiry) Descriptor() ([]byte, []int) {
+	return file_sequencer_api_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *RevisionMetadata_Expiry) GetIndex() []byte {
+	if x != nil {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *RevisionMetadata_Expiry) GetNotAfter() *timestamp.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
 var File_sequencer_api_proto protoreflect.FileDescriptor
 
 var file_sequencer_api_proto_rawDesc = []byte{
@@ -825,146 +942,165 @@ var file_sequencer_api_proto_rawDesc = []byte{
 	0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65,
 	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x70, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x53, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b,
-	0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x70, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65,
-	0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0x7c, 0x0a, 0x0b, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x77, 0x65,
-	0x73, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0f, 0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73,
-	0x69, 0x76, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x65,
-	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
-	0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65,
-	0x12, 0x15, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x9a, 0x01,
-	0x0a, 0x16, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe6, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x70, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x53, 0x0a, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x4d, 0x61, 0x70, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x6c, 0x69, 0x63,
+	0x65, 0x52, 0x07, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x1a, 0x7c, 0x0a, 0x0b, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x53, 0x6c, 0x69, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x6c, 0x6f, 0x77,
+	0x65, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x6c, 0x6f, 0x77, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x76, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76,
+	0x65, 0x12, 0x15, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x49, 0x64, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x81,
+	0x02, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x53, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65,
+	0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52,
+	0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x77, 0x65, 0x65,
+	0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x77, 0x65, 0x65, 0x70, 0x54,
+	0x69, 0x6d, 0x65, 0x1a, 0x57, 0x0a, 0x06, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x37, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x01,
+	0x10, 0x02, 0x22, 0x9a, 0x01, 0x0a, 0x16, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61,
+	0x78, 0x5f, 0x75, 0x6e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x22,
+	0x71, 0x0a, 0x17, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x69,
+	0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x68, 0x69,
+	0x67, 0x68, 0x65, 0x73, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x4a, 0x04, 0x08, 0x01,
+	0x10, 0x02, 0x22, 0x3f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x49, 0x64, 0x22, 0x6f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x70,
+	0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x68, 0x69, 0x67,
+	0x68, 0x65, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x68,
+	0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x65, 0x64, 0x22, 0x3a, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64,
+	0x22, 0x55, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65,
 	0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
-	0x69, 0x6e, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x6d, 0x69, 0x6e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
-	0x62, 0x61, 0x74, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x6e, 0x61,
-	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61,
-	0x78, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x22, 0x71, 0x0a, 0x17, 0x44, 0x65,
-	0x66, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74,
-	0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
-	0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x27,
-	0x0a, 0x0f, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74,
-	0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x3f, 0x0a,
-	0x1a, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0x6f,
-	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x41,
-	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73,
-	0x74, 0x5f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0e, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x22,
-	0x3a, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x70, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x22, 0x52, 0x0a,
+	0x17, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
 	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65,
 	0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0x55, 0x0a, 0x14, 0x41,
-	0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
-	0x6f, 0x6e, 0x22, 0x93, 0x01, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
-	0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x70,
-	0x5f, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d,
-	0x61, 0x70, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x73, 0x22, 0x52, 0x0a, 0x17, 0x50, 0x75, 0x62, 0x6c,
-	0x69, 0x73, 0x68, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x38, 0x0a, 0x18, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6b, 0x0a, 0x16, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f,
+	0x75, 0x6e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x65, 0x0a, 0x17, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x38, 0x0a, 0x18,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x76,
-	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x6b, 0x0a, 0x16, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x6e, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x11, 0x6d, 0x61, 0x78, 0x55, 0x6e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x65, 0x0a, 0x17, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x42,
-	0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x49,
-	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x75, 0x6e, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0xb3, 0x06, 0x0a, 0x18, 0x4b,
-	0x65, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x86, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x66, 0x69,
-	0x6e, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x2e, 0x67, 0x6f,
+	0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x6e, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x75, 0x6e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x32,
+	0xb3, 0x06, 0x0a, 0x18, 0x4b, 0x65, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x86, 0x01, 0x0a,
+	0x0f, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x38, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x44, 0x65,
+	0x66, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x92, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66,
+	0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x44,
-	0x65, 0x66, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b,
-	0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x52,
-	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x92, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x52,
-	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63,
-	0x79, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x44,
-	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e,
-	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66,
-	0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65,
-	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x0e, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x80, 0x01,
+	0x0a, 0x0d, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x36, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x72, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79,
 	0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x89, 0x01, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b,
+	0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
 	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x80, 0x01, 0x0a, 0x0d, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x41, 0x70,
-	0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65, 0x71, 0x75,
-	0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x89, 0x01, 0x0a, 0x10,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x39, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61,
+	0x1a, 0x3a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61,
 	0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
 	0x63, 0x65, 0x72, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x76, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x0f, 0x45, 0x73, 0x74, 0x69,
-	0x6d, 0x61, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x12, 0x38, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x45,
-	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b,
-	0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
-	0x65, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x71, 0x75, 0x65,
-	0x6e, 0x63, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x5f, 0x67,
-	0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a,
+	0x0f, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67,
+	0x12, 0x38, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x72, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b,
+	0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x6b, 0x65, 0x79, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x6b, 0x65, 0x79, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x72, 0x2f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x72, 0x5f, 0x67, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -979,42 +1115,48 @@ func file_sequencer_api_proto_rawDescGZIP() []byte {
 	return file_sequencer_api_proto_rawDescData
 }
 
-var file_sequencer_api_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_sequencer_api_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_sequencer_api_proto_goTypes = []interface{}{
 	(*MapMetadata)(nil),                 // 0: google.keytransparency.sequencer.MapMetadata
-	(*DefineRevisionsRequest)(nil),      // 1: google.keytransparency.sequencer.DefineRevisionsRequest
-	(*DefineRevisionsResponse)(nil),     // 2: google.keytransparency.sequencer.DefineRevisionsResponse
-	(*GetDefinedRevisionsRequest)(nil),  // 3: google.keytransparency.sequencer.GetDefinedRevisionsRequest
-	(*GetDefinedRevisionsResponse)(nil), // 4: google.keytransparency.sequencer.GetDefinedRevisionsResponse
-	(*ApplyRevisionsRequest)(nil),       // 5: google.keytransparency.sequencer.ApplyRevisionsRequest
-	(*ApplyRevisionRequest)(nil),        // 6: google.keytransparency.sequencer.ApplyRevisionRequest
-	(*ApplyRevisionResponse)(nil),       // 7: google.keytransparency.sequencer.ApplyRevisionResponse
-	(*PublishRevisionsRequest)(nil),     // 8: google.keytransparency.sequencer.PublishRevisionsRequest
-	(*PublishRevisionsResponse)(nil),    // 9: google.keytransparency.sequencer.PublishRevisionsResponse
-	(*EstimateBacklogRequest)(nil),      // 10: google.keytransparency.sequencer.EstimateBacklogRequest
-	(*EstimateBacklogResponse)(nil),     // 11: google.keytransparency.sequencer.EstimateBacklogResponse
-	(*MapMetadata_SourceSlice)(nil),     // 12: google.keytransparency.sequencer.MapMetadata.SourceSlice
-	(*empty.Empty)(nil),                 // 13: google.protobuf.Empty
+	(*RevisionMetadata)(nil),            // 1: google.keytransparency.sequencer.RevisionMetadata
+	(*DefineRevisionsRequest)(nil),      // 2: google.keytransparency.sequencer.DefineRevisionsRequest
+	(*DefineRevisionsResponse)(nil),     // 3: google.keytransparency.sequencer.DefineRevisionsResponse
+	(*GetDefinedRevisionsRequest)(nil),  // 4: google.keytransparency.sequencer.GetDefinedRevisionsRequest
+	(*GetDefinedRevisionsResponse)(nil), // 5: google.keytransparency.sequencer.GetDefinedRevisionsResponse
+	(*ApplyRevisionsRequest)(nil),       // 6: google.keytransparency.sequencer.ApplyRevisionsRequest
+	(*ApplyRevisionRequest)(nil),        // 7: google.keytransparency.sequencer.ApplyRevisionRequest
+	(*ApplyRevisionResponse)(nil),       // 8: google.keytransparency.sequencer.ApplyRevisionResponse
+	(*PublishRevisionsRequest)(nil),     // 9: google.keytransparency.sequencer.PublishRevisionsRequest
+	(*PublishRevisionsResponse)(nil),    // 10: google.keytransparency.sequencer.PublishRevisionsResponse
+	(*EstimateBacklogRequest)(nil),      // 11: google.keytransparency.sequencer.EstimateBacklogRequest
+	(*EstimateBacklogResponse)(nil),     // 12: google.keytransparency.sequencer.EstimateBacklogResponse
+	(*MapMetadata_SourceSlice)(nil),     // 13: google.keytransparency.sequencer.MapMetadata.SourceSlice
+	(*RevisionMetadata_Expiry)(nil),     // 14: google.keytransparency.sequencer.RevisionMetadata.Expiry
+	(*timestamp.Timestamp)(nil),         // 15: google.protobuf.Timestamp
+	(*empty.Empty)(nil),                 // 16: google.protobuf.Empty
 }
 var file_sequencer_api_proto_depIdxs = []int32{
-	12, // 0: google.keytransparency.sequencer.MapMetadata.sources:type_name -> google.keytransparency.sequencer.MapMetadata.SourceSlice
-	1,  // 1: google.keytransparency.sequencer.KeyTransparencySequencer.DefineRevisions:input_type -> google.keytransparency.sequencer.DefineRevisionsRequest
-	3,  // 2: google.keytransparency.sequencer.KeyTransparencySequencer.GetDefinedRevisions:input_type -> google.keytransparency.sequencer.GetDefinedRevisionsRequest
-	5,  // 3: google.keytransparency.sequencer.KeyTransparencySequencer.ApplyRevisions:input_type -> google.keytransparency.sequencer.ApplyRevisionsRequest
-	6,  // 4: google.keytransparency.sequencer.KeyTransparencySequencer.ApplyRevision:input_type -> google.keytransparency.sequencer.ApplyRevisionRequest
-	8,  // 5: google.keytransparency.sequencer.KeyTransparencySequencer.PublishRevisions:input_type -> google.keytransparency.sequencer.PublishRevisionsRequest
-	10, // 6: google.keytransparency.sequencer.KeyTransparencySequencer.EstimateBacklog:input_type -> google.keytransparency.sequencer.EstimateBacklogRequest
-	2,  // 7: google.keytransparency.sequencer.KeyTransparencySequencer.DefineRevisions:output_type -> google.keytransparency.sequencer.DefineRevisionsResponse
-	4,  // 8: google.keytransparency.sequencer.KeyTransparencySequencer.GetDefinedRevisions:output_type -> google.keytransparency.sequencer.GetDefinedRevisionsResponse
-	13, // 9: google.keytransparency.sequencer.KeyTransparencySequencer.ApplyRevisions:output_type -> google.protobuf.Empty
-	7,  // 10: google.keytransparency.sequencer.KeyTransparencySequencer.ApplyRevision:output_type -> google.keytransparency.sequencer.ApplyRevisionResponse
-	9,  // 11: google.keytransparency.sequencer.KeyTransparencySequencer.PublishRevisions:output_type -> google.keytransparency.sequencer.PublishRevisionsResponse
-	11, // 12: google.keytransparency.sequencer.KeyTransparencySequencer.EstimateBacklog:output_type -> google.keytransparency.sequencer.EstimateBacklogResponse
-	7,  // [7:13] is the sub-list for method output_type
-	1,  // [1:7] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	13, // 0: google.keytransparency.sequencer.MapMetadata.sources:type_name -> google.keytransparency.sequencer.MapMetadata.SourceSlice
+	14, // 1: google.keytransparency.sequencer.RevisionMetadata.expired:type_name -> google.keytransparency.sequencer.RevisionMetadata.Expiry
+	15, // 2: google.keytransparency.sequencer.RevisionMetadata.sweep_time:type_name -> google.protobuf.Timestamp
+	15, // 3: google.keytransparency.sequencer.RevisionMetadata.Expiry.not_after:type_name -> google.protobuf.Timestamp
+	2,  // 4: google.keytransparency.sequencer.KeyTransparencySequencer.DefineRevisions:input_type -> google.keytransparency.sequencer.DefineRevisionsRequest
+	4,  // 5: google.keytransparency.sequencer.KeyTransparencySequencer.GetDefinedRevisions:input_type -> google.keytransparency.sequencer.GetDefinedRevisionsRequest
+	6,  // 6: google.keytransparency.sequencer.KeyTransparencySequencer.ApplyRevisions:input_type -> google.keytransparency.sequencer.ApplyRevisionsRequest
+	7,  // 7: google.keytransparency.sequencer.KeyTransparencySequencer.ApplyRevision:input_type -> google.keytransparency.sequencer.ApplyRevisionRequest
+	9,  // 8: google.keytransparency.sequencer.KeyTransparencySequencer.PublishRevisions:input_type -> google.keytransparency.sequencer.PublishRevisionsRequest
+	11, // 9: google.keytransparency.sequencer.KeyTransparencySequencer.EstimateBacklog:input_type -> google.keytransparency.sequencer.EstimateBacklogRequest
+	3,  // 10: google.keytransparency.sequencer.KeyTransparencySequencer.DefineRevisions:output_type -> google.keytransparency.sequencer.DefineRevisionsResponse
+	5,  // 11: google.keytransparency.sequencer.KeyTransparencySequencer.GetDefinedRevisions:output_type -> google.keytransparency.sequencer.GetDefinedRevisionsResponse
+	16, // 12: google.keytransparency.sequencer.KeyTransparencySequencer.ApplyRevisions:output_type -> google.protobuf.Empty
+	8,  // 13: google.keytransparency.sequencer.KeyTransparencySequencer.ApplyRevision:output_type -> google.keytransparency.sequencer.ApplyRevisionResponse
+	10, // 14: google.keytransparency.sequencer.KeyTransparencySequencer.PublishRevisions:output_type -> google.keytransparency.sequencer.PublishRevisionsResponse
+	12, // 15: google.keytransparency.sequencer.KeyTransparencySequencer.EstimateBacklog:output_type -> google.keytransparency.sequencer.EstimateBacklogResponse
+	10, // [10:16] is the sub-list for method output_type
+	4,  // [4:10] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_sequencer_api_proto_init() }
@@ -1036,7 +1178,7 @@ func file_sequencer_api_proto_init() {
 			}
 		}
 		file_sequencer_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefineRevisionsRequest); i {
+			switch v := v.(*RevisionMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1048,7 +1190,7 @@ func file_sequencer_api_proto_init() {
 			}
 		}
 		file_sequencer_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DefineRevisionsResponse); i {
+			switch v := v.(*DefineRevisionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1060,7 +1202,7 @@ func file_sequencer_api_proto_init() {
 			}
 		}
 		file_sequencer_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDefinedRevisionsRequest); i {
+			switch v := v.(*DefineRevisionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1072,7 +1214,7 @@ func file_sequencer_api_proto_init() {
 			}
 		}
 		file_sequencer_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDefinedRevisionsResponse); i {
+			switch v := v.(*GetDefinedRevisionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1084,7 +1226,7 @@ func file_sequencer_api_proto_init() {
 			}
 		}
 		file_sequencer_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyRevisionsRequest); i {
+			switch v := v.(*GetDefinedRevisionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1096,7 +1238,7 @@ func file_sequencer_api_proto_init() {
 			}
 		}
 		file_sequencer_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyRevisionRequest); i {
+			switch v := v.(*ApplyRevisionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1108,7 +1250,7 @@ func file_sequencer_api_proto_init() {
 			}
 		}
 		file_sequencer_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyRevisionResponse); i {
+			switch v := v.(*ApplyRevisionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1120,7 +1262,7 @@ func file_sequencer_api_proto_init() {
 			}
 		}
 		file_sequencer_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishRevisionsRequest); i {
+			switch v := v.(*ApplyRevisionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1132,7 +1274,7 @@ func file_sequencer_api_proto_init() {
 			}
 		}
 		file_sequencer_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PublishRevisionsResponse); i {
+			switch v := v.(*PublishRevisionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1144,7 +1286,7 @@ func file_sequencer_api_proto_init() {
 			}
 		}
 		file_sequencer_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateBacklogRequest); i {
+			switch v := v.(*PublishRevisionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1156,7 +1298,7 @@ func file_sequencer_api_proto_init() {
 			}
 		}
 		file_sequencer_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateBacklogResponse); i {
+			switch v := v.(*EstimateBacklogRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1168,6 +1310,18 @@ func file_sequencer_api_proto_init() {
 			}
 		}
 		file_sequencer_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateBacklogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sequencer_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MapMetadata_SourceSlice); i {
 			case 0:
 				return &v.state
@@ -1179,6 +1333,18 @@ func file_sequencer_api_proto_init() {
 				return nil
 			}
 		}
+		file_sequencer_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevisionMetadata_Expiry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1186,7 +1352,7 @@ func file_sequencer_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sequencer_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
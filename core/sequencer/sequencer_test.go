@@ -0,0 +1,95 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sequencer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/trillian/monitoring"
+	"github.com/google/trillian/types"
+	"google.golang.org/grpc"
+
+	"github.com/google/keytransparency/core/sequencer/election"
+	"github.com/google/keytransparency/internal/forcemaster"
+
+	spb "github.com/google/keytransparency/core/sequencer/sequencer_go_proto"
+)
+
+// serverClient calls a Server directly instead of over a connection.
+type serverClient struct {
+	spb.KeyTransparencySequencerClient
+	s *Server
+}
+
+func (c *serverClient) DefineRevisions(ctx context.Context, in *spb.DefineRevisionsRequest,
+	_ ...grpc.CallOption) (*spb.DefineRevisionsResponse, error) {
+	return c.s.DefineRevisions(ctx, in)
+}
+
+func TestDefineRevisionsForAllMasterships(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mapRev := int64(2)
+	dirID := "TestDefineRevisionsForAllMasterships"
+	fakeLogs, _ := setupLogs(ctx, t, dirID, map[int64]int{0: 10})
+	directories := setupDirectory(ctx, t, dirID, time.Hour, 24*time.Hour)
+
+	tracker := election.NewTracker(forcemaster.Factory{}, time.Hour, monitoring.InertMetricFactory{})
+	go tracker.Run(ctx)
+	server := &Server{directories: directories, logs: fakeLogs}
+	sequencer := New(&serverClient{s: server}, directories, tracker)
+	sequencer.AddDirectory(dirID)
+	for {
+		m, err := tracker.Masterships(ctx)
+		if err != nil {
+			t.Fatalf("Masterships(): %v", err)
+		}
+		if len(m) == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond) // Wait to acquire mastership.
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		age      time.Duration // Age of the latest map root.
+		minBatch int32
+		wantNew  bool
+	}{
+		{desc: "min batch", minBatch: 5, wantNew: true},
+		{desc: "before min interval", minBatch: 20, wantNew: false},
+		{desc: "min interval", age: 2 * time.Hour, minBatch: 20, wantNew: true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			batcher := &fakeBatcher{highestRev: mapRev, batches: map[int64]*spb.MapMetadata{mapRev: nil}}
+			server.batcher = batcher
+			server.trillian = &fakeTrillianFactory{
+				tmap: &fakeMap{latestMapRoot: &types.MapRootV1{
+					Revision:       uint64(mapRev),
+					TimestampNanos: uint64(time.Now().Add(-tc.age).UnixNano()),
+				}},
+			}
+
+			if err := sequencer.DefineRevisionsForAllMasterships(ctx, tc.minBatch, 100); err != nil {
+				t.Fatalf("DefineRevisionsForAllMasterships(): %v", err)
+			}
+			if _, got := batcher.batches[mapRev+1]; got != tc.wantNew {
+				t.Errorf("DefineRevisionsForAllMasterships(): defined revision %v: %v, want %v", mapRev+1, got, tc.wantNew)
+			}
+		})
+	}
+}
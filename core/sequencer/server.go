@@ -17,6 +17,7 @@ package sequencer
 import (
 	"context"
 	"fmt"
+	"runtime"
 	"sort"
 	"strconv"
 	"sync"
@@ -24,8 +25,10 @@ import (
 
 	"github.com/golang/glog"
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/google/trillian/monitoring"
+	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -64,6 +67,7 @@ var (
 	fnLatency          monitoring.Histogram
 	logRootTrail       monitoring.Gauge
 	unappliedRevisions monitoring.Gauge
+	expiredEntries     monitoring.Counter
 )
 
 func createMetrics(mf monitoring.MetricFactory) {
@@ -124,6 +128,10 @@ func createMetrics(mf monitoring.MetricFactory) {
 		"unapplied_revisions",
 		"How many revisions have been defined but haven't been applied to the map",
 	)
+	expiredEntries = mf.NewCounter(
+		"expired_entries",
+		"Number of map entries whose not_after time has passed since process start",
+		directoryIDLabel)
 }
 
 // Watermarks is a map of watermarks by logID.
@@ -172,16 +180,47 @@ type Batcher interface {
 	HighestRev(ctx context.Context, directoryID string) (int64, error)
 }
 
+// Expiries stores the not_after times of map entries, indexed by time, so
+// that the entries that expire in a revision can be found without scanning
+// the map.
+type Expiries interface {
+	// WriteExpiries sets the not_after time of the entry at each index. An
+	// expiry without a not_after time clears the expiry of its entry.
+	WriteExpiries(ctx context.Context, directoryID string, expiries []*spb.RevisionMetadata_Expiry) error
+	// ReadExpired returns the expiries of directoryID with a not_after time in
+	// [start, end), ordered by index.
+	ReadExpired(ctx context.Context, directoryID string, start, end time.Time) ([]*spb.RevisionMetadata_Expiry, error)
+}
+
 // Server implements KeyTransparencySequencerServer.
 type Server struct {
 	directories            directory.Storage
 	batcher                Batcher
+	expiries               Expiries
 	trillian               trillianFactory
 	logs                   LogsReader
 	loopback               spb.KeyTransparencySequencerClient
 	BatchSize              int32
 	ApplyRevisionBatchSize uint64
 	LogPublishBatchSize    uint64
+	// ApplyRevisionShards is the number of index ranges ApplyRevision splits
+	// a revision into. Each shard's mutations are joined with the map and
+	// reduced separately. Memory use is bounded by the mutations of the shards
+	// that are processed at once, plus the new leaves of the whole revision,
+	// which are written with a single WriteLeaves.
+	ApplyRevisionShards int
+	// ApplyRevisionParallelism is the maximum number of shards processed at
+	// once. Zero means one per CPU. The revision's mutations are read once
+	// for each group of shards that is processed together, so a value below
+	// ApplyRevisionShards trades extra reads for less memory.
+	ApplyRevisionParallelism int
+	// Runner is the name of the runner.ApplyFn that ApplyRevision uses to
+	// compute new map leaves. The empty name selects the native runner.
+	Runner string
+	// HeartbeatLead is how long before a directory's MaxInterval expires that
+	// an empty heartbeat revision is defined. It allows time for the revision
+	// to be applied and published before clients consider the map stale.
+	HeartbeatLead time.Duration
 }
 
 // NewServer creates a new KeyTransparencySequencerServer.
@@ -191,6 +230,7 @@ func NewServer(
 	tmap tpb.TrillianMapClient,
 	twrite tpb.TrillianMapWriteClient,
 	batcher Batcher,
+	expiries Expiries,
 	logs LogsReader,
 	loopback spb.KeyTransparencySequencerClient,
 	metricsFactory monitoring.MetricFactory,
@@ -205,12 +245,22 @@ func NewServer(
 			twrite:      twrite,
 		},
 		batcher:                batcher,
+		expiries:               expiries,
 		logs:                   logs,
 		loopback:               loopback,
 		BatchSize:              10000,
 		ApplyRevisionBatchSize: 2,
 		LogPublishBatchSize:    10,
+		ApplyRevisionShards:    1,
+	}
+}
+
+// maxShardParallelism returns the number of shards to process at once.
+func (s *Server) maxShardParallelism() int {
+	if s.ApplyRevisionParallelism > 0 {
+		return s.ApplyRevisionParallelism
 	}
+	return runtime.NumCPU()
 }
 
 // EstimateBacklog updates the log_entryunapplied metric for directoryID
@@ -285,11 +335,12 @@ func (s *Server) DefineRevisions(ctx context.Context,
 	//
 	// Rate limit the creation of new batches.
 	//
-
-	// TODO(#1057): If time since last map revision > max timeout, define batch.
-	// TODO(#1047): If time since oldest queue item > max latency has elapsed, define batch.
-	// If count items >= min_batch, define batch.
-	if count >= in.MinBatch {
+	pending := resp.HighestDefined > resp.HighestApplied
+	define, err := s.readyToDefine(ctx, in.DirectoryId, count, in.MinBatch, pending)
+	if err != nil {
+		return nil, err
+	}
+	if define {
 		resp.HighestDefined++
 		nextRev := resp.HighestDefined
 		if err := s.batcher.WriteBatchSources(ctx, in.DirectoryId, nextRev, meta); err != nil {
@@ -305,6 +356,41 @@ func (s *Server) DefineRevisions(ctx context.Context,
 	return resp, nil
 }
 
+// readyToDefine returns true if a new revision containing count items should
+// be defined now. A revision is defined as soon as minBatch items are
+// available, or once the directory's MinInterval has passed since the latest
+// map revision and there is anything to process. A heartbeat revision is
+// defined, even an empty one, HeartbeatLead before MaxInterval has passed if
+// no other revision is pending, so that clients see map roots at least every
+// MaxInterval.
+func (s *Server) readyToDefine(ctx context.Context, directoryID string,
+	count, minBatch int32, pending bool) (bool, error) {
+	if count >= minBatch {
+		return true, nil
+	}
+	dir, err := s.directories.Read(ctx, directoryID, false)
+	if err != nil {
+		return false, err
+	}
+	mapClient, err := s.trillian.MapClient(ctx, directoryID)
+	if err != nil {
+		return false, err
+	}
+	_, root, err := mapClient.GetAndVerifyLatestMapRoot(ctx)
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "GetAndVerifyLatestMapRoot(): %v", err)
+	}
+	elapsed := time.Since(time.Unix(0, int64(root.TimestampNanos)))
+	switch {
+	case count > 0 && elapsed >= dir.MinInterval:
+		return true, nil
+	case !pending && dir.MaxInterval > 0 && elapsed >= dir.MaxInterval-s.HeartbeatLead:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
 // GetDefinedRevisions returns the range of defined and unapplied revisions.
 func (s *Server) GetDefinedRevisions(ctx context.Context,
 	in *spb.GetDefinedRevisionsRequest) (*spb.GetDefinedRevisionsResponse, error) {
@@ -388,7 +474,6 @@ func (s *Server) readMessages(ctx context.Context, source *spb.MapMetadata_Sourc
 			low = batch[chunkSize].ID // Use the last row as the start of the next read.
 			batch = batch[:chunkSize] // Don't emit the next page token.
 		}
-		logEntryCount.Add(float64(len(batch)), directoryID, fmt.Sprintf("%v", source.LogId))
 		for _, m := range batch {
 			emit(m)
 		}
@@ -407,64 +492,115 @@ func (s *Server) ApplyRevision(ctx context.Context, in *spb.ApplyRevisionRequest
 		return nil, status.Errorf(st.Code(), "ReadBatch(%v, %v): %v", in.DirectoryId, in.Revision, st.Message())
 	}
 	glog.Infof("ApplyRevision(): dir: %v, rev: %v, sources: %v", in.DirectoryId, in.Revision, meta)
-
-	incMetricFn := func(label string) { fnCount.Inc(in.DirectoryId, label) }
-
-	logSlices := runner.DoMapMetaFn(mapper.MapMetaFn, meta, incMetricFn)
-	logItems, err := runner.DoReadFn(ctx, s.readMessages, logSlices, in.DirectoryId, s.BatchSize, incMetricFn)
+	directory, err := s.directories.Read(ctx, in.DirectoryId, false)
 	if err != nil {
 		return nil, err
 	}
-
-	emitErrFn := func(err error) {
-		glog.Warning(err)
-		mutationFailures.Inc(in.DirectoryId, status.Code(err).String())
+	mut, err := entry.GetMutator(directory.Mutator, entry.Options{
+		AllowReregistration: directory.AllowReregistration,
+		AdminKeyset:         directory.AdminKeyset,
+	})
+	if err != nil {
+		return nil, err
 	}
-	// Map Log Items
-	indexedValues := runner.DoMapLogItemsFn(entry.MapLogItemFn, logItems, emitErrFn, incMetricFn)
 
-	// Collect Indexes.
-	groupByIndex := make(map[string]bool)
-	for _, iv := range indexedValues {
-		groupByIndex[string(iv.Index)] = true
-	}
-	indexes := make([][]byte, 0, len(groupByIndex))
-	for i := range groupByIndex {
-		indexes = append(indexes, []byte(i))
+	applyFn, err := runner.Get(s.Runner)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "runner.Get(): %v", err)
 	}
-
-	// Read Map.
 	mapClient, err := s.trillian.MapWriteClient(ctx, in.DirectoryId)
 	if err != nil {
 		return nil, err
 	}
-	verifyLeafStart := time.Now()
-	leaves, err := mapClient.GetLeavesByRevision(ctx, in.Revision-1, indexes)
-	fnLatency.Observe(time.Since(verifyLeafStart).Seconds(), in.DirectoryId, "GetLeavesByRevision")
+	incMetricFn := func(label string) { fnCount.Inc(in.DirectoryId, label) }
+	job := &shardJob{
+		directoryID: in.DirectoryId,
+		revision:    in.Revision,
+		numShards:   s.ApplyRevisionShards,
+		logSlices:   runner.DoMapMetaFn(mapper.MapMetaFn, meta, incMetricFn),
+		mapClient:   mapClient,
+		applyFn:     applyFn,
+		fns: &runner.Fns{
+			MapLogItemFn: entry.MapLogItemFn,
+			MapMapLeafFn: mapper.MapMapLeafFn,
+			ReduceFn:     entry.NewReduceFn(mut.Mutate, in.Revision),
+			Reduce: runner.ReduceSpec{
+				Mutator:             directory.Mutator,
+				AllowReregistration: directory.AllowReregistration,
+				AdminKeyset:         directory.AdminKeyset,
+				Revision:            in.Revision,
+			},
+		},
+		emitErrFn: func(err error) {
+			glog.Warning(err)
+			mutationFailures.Inc(in.DirectoryId, status.Code(err).String())
+		},
+		incMetricFn: incMetricFn,
+	}
+	if job.numShards < 1 {
+		job.numShards = 1
+	}
+
+	// Process the shards of the index space in groups of up to
+	// maxShardParallelism, so that only one group's messages are held in
+	// memory at once. Each group reads the revision's log slices concurrently
+	// and then applies its shards concurrently, each shard writing to its own
+	// result slot. Trillian creates a new map revision for every WriteLeaves,
+	// so the shards' leaves are written together, in index order, with a
+	// single WriteLeaves.
+	results := make([]*shardResult, job.numShards)
+	group := s.maxShardParallelism()
+	for first := 0; first < job.numShards; first += group {
+		end := first + group
+		if end > job.numShards {
+			end = job.numShards
+		}
+		msgs, err := s.readShards(ctx, job, first, end)
+		if err != nil {
+			return nil, err
+		}
+		g, gctx := errgroup.WithContext(ctx)
+		for shard := first; shard < end; shard++ {
+			shard, logItems := shard, msgs[shard-first]
+			g.Go(func() error {
+				res, err := s.applyShard(gctx, job, logItems)
+				if err != nil {
+					return err
+				}
+				results[shard] = res
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return nil, err
+		}
+	}
+	// Shards are contiguous index ranges, so concatenating them in shard
+	// order keeps the leaves sorted by index.
+	var newLeaves []*tpb.MapLeaf
+	var receivedItems []received
+	var mutations, indexes int
+	for _, res := range results {
+		newLeaves = append(newLeaves, res.leaves...)
+		receivedItems = append(receivedItems, res.received...)
+		mutations += res.mutations
+		indexes += res.indexes
+	}
+
+	// Sweep for expired entries.
+	revMeta, err := s.sweepExpiries(ctx, in.DirectoryId, in.Revision, newLeaves)
 	if err != nil {
 		return nil, err
 	}
-
-	computeStart := time.Now()
-	// Convert Trillian map leaves into indexed KT updates.
-	indexedLeaves, err := runner.DoMapMapLeafFn(mapper.MapMapLeafFn, leaves, incMetricFn)
+	expiredEntries.Add(float64(len(revMeta.GetExpired())), in.DirectoryId)
+	revMetaData, err := proto.Marshal(revMeta)
 	if err != nil {
 		return nil, err
 	}
 
-	// GroupByIndex.
-	joined := runner.Join(indexedLeaves, indexedValues, incMetricFn)
-
-	// Apply mutations to values.
-	newIndexedLeaves := runner.DoReduceFn(entry.ReduceFn, joined, emitErrFn, incMetricFn)
-
-	// Marshal new indexed values back into Trillian Map leaves.
-	newLeaves := runner.DoMarshalIndexedValues(newIndexedLeaves, emitErrFn, incMetricFn)
-	fnLatency.Observe(time.Since(computeStart).Seconds(), in.DirectoryId, "ProcessMutations")
-
 	// Set new leaf values.
 	setRevisionStart := time.Now()
-	err = mapClient.WriteLeaves(ctx, in.Revision, newLeaves)
+	err = mapClient.WriteLeaves(ctx, in.Revision, newLeaves, revMetaData)
 	fnLatency.Observe(time.Since(setRevisionStart).Seconds(), in.DirectoryId, "WriteLeaves")
 	if err != nil {
 		return nil, err
@@ -472,8 +608,9 @@ func (s *Server) ApplyRevision(ctx context.Context, in *spb.ApplyRevisionRequest
 	glog.V(2).Infof("CreateRevision: WriteLeaves:{Revision: %v, MapLeaves: %v}", in.Revision, len(newLeaves))
 
 	writtenAt := time.Now()
-	for _, li := range logItems {
-		appliedLatency.Observe(writtenAt.Sub(li.CreatedAt).Seconds(), in.DirectoryId, strconv.FormatInt(li.LogID, 10))
+	for _, r := range receivedItems {
+		appliedLatency.Observe(writtenAt.Sub(r.createdAt).Seconds(), in.DirectoryId, strconv.FormatInt(r.logID, 10))
+		logEntryCount.Inc(in.DirectoryId, strconv.FormatInt(r.logID, 10))
 	}
 
 	for _, s := range meta.Sources {
@@ -481,16 +618,62 @@ func (s *Server) ApplyRevision(ctx context.Context, in *spb.ApplyRevisionRequest
 	}
 	mapLeafCount.Add(float64(len(newLeaves)), in.DirectoryId)
 	mapRevisionCount.Inc(in.DirectoryId)
-	glog.Infof("ApplyRevision(): dir: %v, rev: %v, mutations: %v, indexes: %v, newleaves: %v",
-		in.DirectoryId, in.Revision, len(logItems), len(indexes), len(newLeaves))
+	glog.Infof("ApplyRevision(): dir: %v, rev: %v, shards: %v, mutations: %v, indexes: %v, newleaves: %v",
+		in.DirectoryId, in.Revision, job.numShards, len(receivedItems), indexes, len(newLeaves))
 	return &spb.ApplyRevisionResponse{
 		DirectoryId: in.DirectoryId,
 		Revision:    in.Revision,
-		Mutations:   int64(len(indexedValues)),
+		Mutations:   int64(mutations),
 		MapLeaves:   int64(len(newLeaves)),
 	}, nil
 }
 
+// sweepExpiries records the expiries of the leaves written in revision and
+// returns the metadata for revision, which lists the entries that expired
+// since the previous revision was swept.
+func (s *Server) sweepExpiries(ctx context.Context, directoryID string, revision int64,
+	leaves []*tpb.MapLeaf) (*spb.RevisionMetadata, error) {
+	mapClient, err := s.trillian.MapClient(ctx, directoryID)
+	if err != nil {
+		return nil, err
+	}
+	_, prevRoot, err := mapClient.GetAndVerifyMapRootByRevision(ctx, revision-1)
+	if err != nil {
+		return nil, err
+	}
+	var prev spb.RevisionMetadata
+	if err := proto.Unmarshal(prevRoot.Metadata, &prev); err != nil {
+		return nil, status.Errorf(codes.DataLoss, "proto.Unmarshal(map root metadata): %v", err)
+	}
+	start := time.Unix(0, 0)
+	if prev.SweepTime != nil {
+		if start, err = ptypes.Timestamp(prev.SweepTime); err != nil {
+			return nil, status.Errorf(codes.DataLoss, "invalid sweep time: %v", err)
+		}
+	}
+
+	expiries, err := leafExpiries(leaves)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.expiries.WriteExpiries(ctx, directoryID, expiries); err != nil {
+		return nil, err
+	}
+	end := time.Now()
+	if end.Before(start) {
+		end = start
+	}
+	expired, err := s.expiries.ReadExpired(ctx, directoryID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	sweepTime, err := ptypes.TimestampProto(end)
+	if err != nil {
+		return nil, err
+	}
+	return &spb.RevisionMetadata{Expired: expired, SweepTime: sweepTime}, nil
+}
+
 // PublishRevisions copies the MapRoots of all known map revisions into the Log of MapRoots.
 func (s *Server) PublishRevisions(ctx context.Context,
 	in *spb.PublishRevisionsRequest) (*spb.PublishRevisionsResponse, error) {
@@ -553,7 +736,7 @@ func (s *Server) PublishRevisions(ctx context.Context,
 
 // HighWatermarks returns the total count across all logs and the highest watermark for each log.
 // batchSize is a limit on the total number of items represented by the returned watermarks.
-// TODO(gbelvin): Block until a minBatchSize has been reached or a timeout has occurred.
+// The budget is shared fairly between logs, which are queried concurrently.
 func (s *Server) HighWatermarks(ctx context.Context, directoryID string, lastMeta *spb.MapMetadata,
 	batchSize int32) (int32, *spb.MapMetadata, error) {
 	var total int32
@@ -579,18 +762,58 @@ func (s *Server) HighWatermarks(ctx context.Context, directoryID string, lastMet
 	if err != nil {
 		return 0, nil, err
 	}
-	// TODO(gbelvin): Get HighWatermarks in parallel.
 	for _, logID := range logIDs {
-		low := ends[logID]
-		count, high, err := s.logs.HighWatermark(ctx, directoryID, logID, low, batchSize)
-		if err != nil {
-			return 0, nil, status.Errorf(codes.Internal,
-				"HighWatermark(%v/%v, start: %v, batch: %v): %v",
-				directoryID, logID, low, batchSize, err)
+		// Report every log, even those that have nothing to read.
+		starts[logID], ends[logID] = ends[logID], ends[logID]
+	}
+
+	// Share batchSize fairly between logs so that one busy log cannot starve
+	// the others. Each round splits the remaining budget evenly between the
+	// logs that may still have unread items and queries them concurrently.
+	// A log that returns fewer items than its share has been fully read.
+	// The logs that receive the remainder of the budget rotate from revision
+	// to revision, so a budget smaller than the number of logs reaches every
+	// log.
+	active := append([]int64(nil), logIDs...)
+	sort.Slice(active, func(a, b int) bool { return active[a] < active[b] })
+	start := remainderStart(active, lastMeta)
+	for batchSize > 0 && len(active) > 0 {
+		offset := sort.Search(len(active), func(i int) bool { return active[i] >= start })
+		shares := fairShares(batchSize, len(active), offset%len(active))
+		counts := make([]int32, len(active))
+		highs := make([]water.Mark, len(active))
+		g, gctx := errgroup.WithContext(ctx)
+		for i, logID := range active {
+			i, logID, low := i, logID, ends[logID]
+			highs[i] = low
+			if shares[i] == 0 {
+				continue
+			}
+			g.Go(func() error {
+				count, high, err := s.logs.HighWatermark(gctx, directoryID, logID, low, shares[i])
+				if err != nil {
+					return status.Errorf(codes.Internal,
+						"HighWatermark(%v/%v, start: %v, batch: %v): %v",
+						directoryID, logID, low, shares[i], err)
+				}
+				counts[i], highs[i] = count, high
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return 0, nil, err
 		}
-		starts[logID], ends[logID] = low, high
-		total += count
-		batchSize -= count
+
+		next := make([]int64, 0, len(active))
+		for i, logID := range active {
+			ends[logID] = highs[i]
+			total += counts[i]
+			batchSize -= counts[i]
+			if shares[i] == 0 || counts[i] >= shares[i] {
+				next = append(next, logID)
+			}
+		}
+		active = next
 	}
 
 	meta := &spb.MapMetadata{}
@@ -604,3 +827,43 @@ func (s *Server) HighWatermarks(ctx context.Context, directoryID string, lastMet
 	})
 	return total, meta, nil
 }
+
+// remainderStart returns the log that receives the first share of the
+// remainder of the budget. It is the log after the logs that advanced in
+// lastMeta, taken in order of log ID and wrapping around, so the remainder
+// moves on from the logs that received it in the previous revision. It is the
+// first log if all or none of the logs advanced. logIDs must be sorted.
+func remainderStart(logIDs []int64, lastMeta *spb.MapMetadata) int64 {
+	advanced := make(map[int64]bool)
+	for _, source := range lastMeta.GetSources() {
+		s := metadata.FromProto(source)
+		if s.HighMark().Compare(s.LowMark()) > 0 {
+			advanced[source.LogId] = true
+		}
+	}
+	for i, logID := range logIDs {
+		next := logIDs[(i+1)%len(logIDs)]
+		if advanced[logID] && !advanced[next] {
+			return next
+		}
+	}
+	if len(logIDs) == 0 {
+		return 0
+	}
+	return logIDs[0]
+}
+
+// fairShares divides budget into n shares that differ by at most one.
+// The budget % n shares starting at offset, wrapping around, receive the
+// remainder.
+func fairShares(budget int32, n, offset int) []int32 {
+	shares := make([]int32, n)
+	q, r := budget/int32(n), int(budget%int32(n))
+	for i := range shares {
+		shares[i] = q
+	}
+	for i := 0; i < r; i++ {
+		shares[(offset+i)%n]++
+	}
+	return shares
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/proto" //nolint:staticcheck
 	"github.com/google/go-cmp/cmp"
@@ -25,6 +26,7 @@ import (
 	"github.com/google/trillian/types"
 	"google.golang.org/grpc"
 
+	"github.com/google/keytransparency/core/directory"
 	"github.com/google/keytransparency/core/sequencer/mapper"
 	"github.com/google/keytransparency/core/sequencer/metadata"
 	"github.com/google/keytransparency/core/sequencer/runner"
@@ -36,8 +38,6 @@ import (
 	tpb "github.com/google/trillian"
 )
 
-const directoryID = "directoryID"
-
 var zero = water.Mark{}
 
 func fakeMetric(_ string) {}
@@ -100,7 +100,7 @@ func (b *fakeBatcher) ReadBatch(_ context.Context, _ string, rev int64) (*spb.Ma
 	return meta, nil
 }
 
-func setupLogs(ctx context.Context, t *testing.T, dirID string, logLengths map[int64]int) (memory.MutationLogs, map[int64][]water.Mark) {
+func setupLogs(ctx context.Context, t *testing.T, dirID string, logLengths map[int64]int) (*memory.MutationLogs, map[int64][]water.Mark) {
 	t.Helper()
 	fakeLogs := memory.NewMutationLogs()
 	idx := make(map[int64][]water.Mark)
@@ -119,10 +119,124 @@ func setupLogs(ctx context.Context, t *testing.T, dirID string, logLengths map[i
 	return fakeLogs, idx
 }
 
+func setupDirectory(ctx context.Context, t *testing.T, dirID string, minInterval, maxInterval time.Duration) directory.Storage {
+	t.Helper()
+	directories := memory.NewDirectoryStorage()
+	if err := directories.Write(ctx, &directory.Directory{
+		DirectoryID: dirID,
+		MinInterval: minInterval,
+		MaxInterval: maxInterval,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return directories
+}
+
 func newSource(logID int64, low, high water.Mark) *spb.MapMetadata_SourceSlice {
 	return metadata.New(logID, low, high).Proto()
 }
 
+func TestHighWatermarksFairShare(t *testing.T) {
+	ctx := context.Background()
+	dirID := "TestHighWatermarksFairShare"
+	// Log 0 is much busier than the others.
+	fakeLogs, idx := setupLogs(ctx, t, dirID, map[int64]int{0: 100, 1: 5, 2: 8, 3: 20})
+	s := Server{logs: fakeLogs}
+
+	for _, tc := range []struct {
+		desc      string
+		batchSize int32
+		count     int32
+		next      *spb.MapMetadata
+	}{
+		{desc: "even", batchSize: 40, count: 40,
+			next: &spb.MapMetadata{Sources: []*spb.MapMetadata_SourceSlice{
+				newSource(0, zero, idx[0][13].Add(1)),
+				newSource(1, zero, idx[1][4].Add(1)),
+				newSource(2, zero, idx[2][7].Add(1)),
+				newSource(3, zero, idx[3][12].Add(1)),
+			}}},
+		{desc: "remainder", batchSize: 3, count: 3,
+			next: &spb.MapMetadata{Sources: []*spb.MapMetadata_SourceSlice{
+				newSource(0, zero, idx[0][0].Add(1)),
+				newSource(1, zero, idx[1][0].Add(1)),
+				newSource(2, zero, idx[2][0].Add(1)),
+				newSource(3, zero, zero),
+			}}},
+		{desc: "drain quiet logs", batchSize: 100, count: 100,
+			next: &spb.MapMetadata{Sources: []*spb.MapMetadata_SourceSlice{
+				newSource(0, zero, idx[0][66].Add(1)),
+				newSource(1, zero, idx[1][4].Add(1)),
+				newSource(2, zero, idx[2][7].Add(1)),
+				newSource(3, zero, idx[3][19].Add(1)),
+			}}},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			count, next, err := s.HighWatermarks(ctx, dirID, nil, tc.batchSize)
+			if err != nil {
+				t.Fatalf("HighWatermarks(): %v", err)
+			}
+			if count != tc.count {
+				t.Errorf("HighWatermarks(): count: %v, want %v", count, tc.count)
+			}
+			if !proto.Equal(next, tc.next) {
+				t.Errorf("HighWatermarks(): diff(-got, +want): %v", cmp.Diff(next, tc.next))
+			}
+		})
+	}
+}
+
+func TestHighWatermarksRotateRemainder(t *testing.T) {
+	ctx := context.Background()
+	dirID := "TestHighWatermarksRotateRemainder"
+	fakeLogs, _ := setupLogs(ctx, t, dirID, map[int64]int{0: 10, 1: 10, 2: 10})
+	s := Server{logs: fakeLogs}
+
+	// A budget smaller than the number of logs reaches every log in turn.
+	var meta *spb.MapMetadata
+	for _, want := range [][]int64{{0, 1}, {0, 2}, {1, 2}, {0, 1}} {
+		_, next, err := s.HighWatermarks(ctx, dirID, meta, 2)
+		if err != nil {
+			t.Fatalf("HighWatermarks(): %v", err)
+		}
+		// The same inputs produce the same watermarks.
+		_, again, err := s.HighWatermarks(ctx, dirID, meta, 2)
+		if err != nil {
+			t.Fatalf("HighWatermarks(): %v", err)
+		}
+		if !proto.Equal(again, next) {
+			t.Errorf("HighWatermarks(): %v, then %v", next, again)
+		}
+		var advanced []int64
+		for _, source := range next.GetSources() {
+			if src := metadata.FromProto(source); src.HighMark().Compare(src.LowMark()) > 0 {
+				advanced = append(advanced, source.LogId)
+			}
+		}
+		if !cmp.Equal(advanced, want) {
+			t.Errorf("HighWatermarks(%v): advanced logs %v, want %v", meta, advanced, want)
+		}
+		meta = next
+	}
+}
+
+func TestFairShares(t *testing.T) {
+	for _, tc := range []struct {
+		budget    int32
+		n, offset int
+		want      []int32
+	}{
+		{budget: 9, n: 3, offset: 0, want: []int32{3, 3, 3}},
+		{budget: 10, n: 3, offset: 0, want: []int32{4, 3, 3}},
+		{budget: 10, n: 3, offset: 2, want: []int32{3, 3, 4}},
+		{budget: 2, n: 3, offset: 2, want: []int32{1, 0, 1}},
+	} {
+		if got := fairShares(tc.budget, tc.n, tc.offset); !cmp.Equal(got, tc.want) {
+			t.Errorf("fairShares(%v, %v, %v): %v, want %v", tc.budget, tc.n, tc.offset, got, tc.want)
+		}
+	}
+}
+
 func TestDefiningRevisions(t *testing.T) {
 	// Verify that outstanding revisions prevent future revisions from being created.
 	ctx := context.Background()
@@ -130,9 +244,13 @@ func TestDefiningRevisions(t *testing.T) {
 	dirID := "foobar"
 	fakeLogs, idx := setupLogs(ctx, t, dirID, map[int64]int{0: 10, 1: 20})
 	s := Server{
-		logs: fakeLogs,
+		directories: setupDirectory(ctx, t, dirID, time.Hour, 24*time.Hour),
+		logs:        fakeLogs,
 		trillian: &fakeTrillianFactory{
-			tmap: &fakeMap{latestMapRoot: &types.MapRootV1{Revision: uint64(mapRev)}},
+			tmap: &fakeMap{latestMapRoot: &types.MapRootV1{
+				Revision:       uint64(mapRev),
+				TimestampNanos: uint64(time.Now().UnixNano()),
+			}},
 		},
 	}
 
@@ -163,10 +281,10 @@ func TestDefiningRevisions(t *testing.T) {
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			s.batcher = &fakeBatcher{highestRev: tc.highestRev, batches: make(map[int64]*spb.MapMetadata)}
-			s.batcher.WriteBatchSources(ctx, directoryID, tc.highestRev, tc.meta)
+			s.batcher.WriteBatchSources(ctx, dirID, tc.highestRev, tc.meta)
 
 			gdrResp, err := s.GetDefinedRevisions(ctx,
-				&spb.GetDefinedRevisionsRequest{DirectoryId: directoryID})
+				&spb.GetDefinedRevisionsRequest{DirectoryId: dirID})
 			if err != nil {
 				t.Fatalf("GetDefinedRevisions(): %v", err)
 			}
@@ -179,7 +297,7 @@ func TestDefiningRevisions(t *testing.T) {
 			}
 
 			drResp, err := s.DefineRevisions(ctx, &spb.DefineRevisionsRequest{
-				DirectoryId:  directoryID,
+				DirectoryId:  dirID,
 				MinBatch:     1,
 				MaxBatch:     10,
 				MaxUnapplied: tc.maxGap})
@@ -197,6 +315,68 @@ func TestDefiningRevisions(t *testing.T) {
 	}
 }
 
+func TestDefineRevisionsIntervals(t *testing.T) {
+	ctx := context.Background()
+	mapRev := int64(2)
+	dirID := "TestDefineRevisionsIntervals"
+	fakeLogs, idx := setupLogs(ctx, t, dirID, map[int64]int{0: 10})
+	directories := setupDirectory(ctx, t, dirID, time.Hour, 24*time.Hour)
+	drained := &spb.MapMetadata{Sources: []*spb.MapMetadata_SourceSlice{
+		newSource(0, zero, idx[0][9].Add(1)),
+	}}
+
+	for _, tc := range []struct {
+		desc       string
+		age        time.Duration // Age of the latest map root.
+		lead       time.Duration
+		minBatch   int32
+		meta       *spb.MapMetadata
+		highestRev int64
+		wantNew    int64
+	}{
+		{desc: "min batch", minBatch: 5, highestRev: mapRev, wantNew: mapRev + 1},
+		{desc: "below min batch", minBatch: 20, highestRev: mapRev, wantNew: mapRev},
+		{desc: "min interval", age: 2 * time.Hour, minBatch: 20, highestRev: mapRev, wantNew: mapRev + 1},
+		{desc: "empty", age: 2 * time.Hour, minBatch: 1, meta: drained, highestRev: mapRev, wantNew: mapRev},
+		{desc: "max interval", age: 25 * time.Hour, minBatch: 1, meta: drained, highestRev: mapRev, wantNew: mapRev + 1},
+		{desc: "before heartbeat lead", age: 22 * time.Hour, lead: time.Hour, minBatch: 1, meta: drained,
+			highestRev: mapRev, wantNew: mapRev},
+		{desc: "heartbeat lead", age: 23 * time.Hour, lead: time.Hour, minBatch: 1, meta: drained,
+			highestRev: mapRev, wantNew: mapRev + 1},
+		{desc: "max interval pending", age: 25 * time.Hour, minBatch: 1, meta: drained,
+			highestRev: mapRev + 1, wantNew: mapRev + 1},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			s := Server{
+				directories:   directories,
+				logs:          fakeLogs,
+				batcher:       &fakeBatcher{highestRev: tc.highestRev, batches: make(map[int64]*spb.MapMetadata)},
+				HeartbeatLead: tc.lead,
+				trillian: &fakeTrillianFactory{
+					tmap: &fakeMap{latestMapRoot: &types.MapRootV1{
+						Revision:       uint64(mapRev),
+						TimestampNanos: uint64(time.Now().Add(-tc.age).UnixNano()),
+					}},
+				},
+			}
+			s.batcher.WriteBatchSources(ctx, dirID, tc.highestRev, tc.meta)
+
+			resp, err := s.DefineRevisions(ctx, &spb.DefineRevisionsRequest{
+				DirectoryId:  dirID,
+				MinBatch:     tc.minBatch,
+				MaxBatch:     100,
+				MaxUnapplied: 1,
+			})
+			if err != nil {
+				t.Fatalf("DefineRevisions(): %v", err)
+			}
+			if got := resp.HighestDefined; got != tc.wantNew {
+				t.Errorf("DefineRevisions(): HighestDefined: %v, want %v", got, tc.wantNew)
+			}
+		})
+	}
+}
+
 func TestReadMessages(t *testing.T) {
 	ctx := context.Background()
 	dirID := "TestReadMessages"
@@ -220,7 +400,7 @@ func TestReadMessages(t *testing.T) {
 		}}},
 	} {
 		logSlices := runner.DoMapMetaFn(mapper.MapMetaFn, tc.meta, fakeMetric)
-		logItems, err := runner.DoReadFn(ctx, s.readMessages, logSlices, directoryID, tc.batchSize, fakeMetric)
+		logItems, err := runner.DoReadFn(ctx, s.readMessages, logSlices, dirID, tc.batchSize, fakeMetric)
 		if err != nil {
 			t.Errorf("readMessages(): %v", err)
 		}
@@ -283,7 +463,7 @@ func TestHighWatermarks(t *testing.T) {
 			}}},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			count, next, err := s.HighWatermarks(ctx, directoryID, tc.last, tc.batchSize)
+			count, next, err := s.HighWatermarks(ctx, dirID, tc.last, tc.batchSize)
 			if err != nil {
 				t.Fatalf("HighWatermarks(): %v", err)
 			}
@@ -0,0 +1,148 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sequencer
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"math/bits"
+	"sort"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/google/keytransparency/core/mutator"
+	"github.com/google/keytransparency/core/mutator/entry"
+	"github.com/google/keytransparency/core/sequencer/runner"
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	spb "github.com/google/keytransparency/core/sequencer/sequencer_go_proto"
+	tpb "github.com/google/trillian"
+)
+
+// indexShard returns which of numShards contiguous ranges of the index space
+// index falls into. Indexes are VRF outputs, so shards are evenly loaded.
+func indexShard(index []byte, numShards int) int {
+	if numShards <= 1 {
+		return 0
+	}
+	var prefix [8]byte
+	copy(prefix[:], index)
+	hi, _ := bits.Mul64(binary.BigEndian.Uint64(prefix[:]), uint64(numShards))
+	return int(hi)
+}
+
+// received records when a log message was received so that the latency of
+// applying it can be measured without retaining the message itself.
+type received struct {
+	logID     int64
+	createdAt time.Time
+}
+
+// shardResult is the output of applying one shard of a revision.
+type shardResult struct {
+	leaves    []*tpb.MapLeaf
+	received  []received
+	mutations int
+	indexes   int
+}
+
+// shardJob holds the inputs shared by all the shards of a revision.
+type shardJob struct {
+	directoryID string
+	revision    int64
+	numShards   int
+	logSlices   []*spb.MapMetadata_SourceSlice
+	mapClient   *MapWriteClient
+	applyFn     runner.ApplyFn
+	fns         *runner.Fns
+	emitErrFn   func(error)
+	incMetricFn runner.IncMetricFn
+}
+
+// readShards reads the log messages of the shards in [first, end) and divides
+// them between those shards by the index that each message's mutation
+// updates. The log slices are read concurrently, and messages of other shards
+// are dropped as they are read. Each shard's messages are in log slice order.
+func (s *Server) readShards(ctx context.Context, job *shardJob, first, end int) ([][]*mutator.LogMessage, error) {
+	bySlice := make([][][]*mutator.LogMessage, len(job.logSlices))
+	g, gctx := errgroup.WithContext(ctx)
+	for i, slice := range job.logSlices {
+		i, slice := i, slice
+		g.Go(func() error {
+			job.incMetricFn("ReadSliceFn")
+			shards := make([][]*mutator.LogMessage, end-first)
+			bySlice[i] = shards
+			return s.readMessages(gctx, slice, job.directoryID, s.BatchSize, func(m *mutator.LogMessage) {
+				if shard := messageShard(m, job.numShards); first <= shard && shard < end {
+					shards[shard-first] = append(shards[shard-first], m)
+				}
+			})
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	shards := make([][]*mutator.LogMessage, end-first)
+	for _, sliceShards := range bySlice {
+		for i, msgs := range sliceShards {
+			shards[i] = append(shards[i], msgs...)
+		}
+	}
+	return shards, nil
+}
+
+// applyShard computes the new map leaves for the indexes of one shard from
+// the log messages in logItems. The leaves are returned in index order.
+func (s *Server) applyShard(ctx context.Context, job *shardJob, logItems []*mutator.LogMessage) (*shardResult, error) {
+	ret := &shardResult{received: make([]received, 0, len(logItems))}
+	for _, li := range logItems {
+		ret.received = append(ret.received, received{logID: li.LogID, createdAt: li.CreatedAt})
+	}
+
+	readLeavesFn := func(ctx context.Context, indexes [][]byte) ([]*tpb.MapLeaf, error) {
+		if len(indexes) == 0 {
+			return nil, nil // Nothing to read in this shard.
+		}
+		verifyLeafStart := time.Now()
+		leaves, err := job.mapClient.GetLeavesByRevision(ctx, job.revision-1, indexes)
+		fnLatency.Observe(time.Since(verifyLeafStart).Seconds(), job.directoryID, "GetLeavesByRevision")
+		return leaves, err
+	}
+	computeStart := time.Now()
+	out, err := job.applyFn(ctx, job.fns, logItems, readLeavesFn, job.emitErrFn, job.incMetricFn)
+	if err != nil {
+		return nil, err
+	}
+	fnLatency.Observe(time.Since(computeStart).Seconds(), job.directoryID, "ProcessMutations")
+	ret.leaves, ret.mutations, ret.indexes = out.Leaves, out.Mutations, out.Indexes
+	sort.Slice(ret.leaves, func(a, b int) bool {
+		return bytes.Compare(ret.leaves[a].Index, ret.leaves[b].Index) < 0
+	})
+	return ret, nil
+}
+
+// messageShard returns the shard of the mutation in m.
+// Mutations that cannot be parsed are assigned to the first shard, which
+// reports them as errors.
+func messageShard(m *mutator.LogMessage, numShards int) int {
+	shard := 0
+	entry.MapLogItemFn(m,
+		func(index []byte, _ *pb.EntryUpdate) { shard = indexShard(index, numShards) },
+		func(error) {},
+	)
+	return shard
+}
@@ -0,0 +1,141 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sequencer
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/keytransparency/core/mutator"
+	"github.com/google/keytransparency/core/sequencer/mapper"
+	"github.com/google/keytransparency/core/sequencer/runner"
+	"github.com/google/keytransparency/impl/memory"
+
+	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
+	spb "github.com/google/keytransparency/core/sequencer/sequencer_go_proto"
+)
+
+func TestIndexShard(t *testing.T) {
+	for _, tc := range []struct {
+		index     []byte
+		numShards int
+		want      int
+	}{
+		{index: bytes.Repeat([]byte{0xff}, 32), numShards: 0, want: 0},
+		{index: bytes.Repeat([]byte{0xff}, 32), numShards: 1, want: 0},
+		{index: make([]byte, 32), numShards: 4, want: 0},
+		{index: append([]byte{0x3f}, bytes.Repeat([]byte{0xff}, 31)...), numShards: 4, want: 0},
+		{index: append([]byte{0x40}, make([]byte, 31)...), numShards: 4, want: 1},
+		{index: append([]byte{0x80}, make([]byte, 31)...), numShards: 4, want: 2},
+		{index: bytes.Repeat([]byte{0xff}, 32), numShards: 4, want: 3},
+		{index: bytes.Repeat([]byte{0xff}, 32), numShards: 3, want: 2},
+		{index: []byte{0x80}, numShards: 2, want: 1}, // Short indexes are padded.
+	} {
+		if got := indexShard(tc.index, tc.numShards); got != tc.want {
+			t.Errorf("indexShard(%x, %v): %v, want %v", tc.index, tc.numShards, got, tc.want)
+		}
+	}
+}
+
+func TestMessageShard(t *testing.T) {
+	entryData, err := proto.Marshal(&pb.Entry{Index: append([]byte{0x80}, make([]byte, 31)...)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	valid := &mutator.LogMessage{Mutation: &pb.SignedEntry{Entry: entryData}}
+	invalid := &mutator.LogMessage{Mutation: &pb.SignedEntry{Entry: []byte("not an entry")}}
+
+	for _, tc := range []struct {
+		desc string
+		msg  *mutator.LogMessage
+		want int
+	}{
+		{desc: "valid", msg: valid, want: 1},
+		{desc: "invalid", msg: invalid, want: 0},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := messageShard(tc.msg, 2); got != tc.want {
+				t.Errorf("messageShard(): %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestReadShards(t *testing.T) {
+	ctx := context.Background()
+	dirID := "TestReadShards"
+	const numShards = 4
+	fakeLogs := memory.NewMutationLogs()
+	meta := &spb.MapMetadata{}
+	for logID := int64(0); logID < 2; logID++ {
+		if err := fakeLogs.AddLogs(ctx, dirID, logID); err != nil {
+			t.Fatal(err)
+		}
+		// Spread the mutations of each log evenly over the index space.
+		var updates []*pb.EntryUpdate
+		for i := 0; i < 8; i++ {
+			entryData, err := proto.Marshal(&pb.Entry{Index: append([]byte{byte(i * 32)}, make([]byte, 31)...)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			updates = append(updates, &pb.EntryUpdate{Mutation: &pb.SignedEntry{Entry: entryData}})
+		}
+		wm, err := fakeLogs.SendBatch(ctx, dirID, logID, updates)
+		if err != nil {
+			t.Fatal(err)
+		}
+		meta.Sources = append(meta.Sources, newSource(logID, zero, wm.Add(1)))
+	}
+	s := Server{logs: fakeLogs, BatchSize: 3}
+	job := &shardJob{
+		directoryID: dirID,
+		numShards:   numShards,
+		logSlices:   runner.DoMapMetaFn(mapper.MapMetaFn, meta, fakeMetric),
+		incMetricFn: fakeMetric,
+	}
+
+	for _, tc := range []struct {
+		first, end int
+	}{
+		{first: 0, end: numShards},
+		{first: 1, end: 3},
+		{first: 3, end: 4},
+	} {
+		shards, err := s.readShards(ctx, job, tc.first, tc.end)
+		if err != nil {
+			t.Fatalf("readShards(%v, %v): %v", tc.first, tc.end, err)
+		}
+		if got, want := len(shards), tc.end-tc.first; got != want {
+			t.Fatalf("readShards(%v, %v): %v shards, want %v", tc.first, tc.end, got, want)
+		}
+		for i, msgs := range shards {
+			var logIDs []int64
+			for _, m := range msgs {
+				if got, want := messageShard(m, numShards), tc.first+i; got != want {
+					t.Errorf("readShards(%v, %v): message of shard %v in shard %v", tc.first, tc.end, got, want)
+				}
+				logIDs = append(logIDs, m.LogID)
+			}
+			// Each shard holds two mutations from each log, in log slice order.
+			if want := []int64{0, 0, 1, 1}; !cmp.Equal(logIDs, want) {
+				t.Errorf("readShards(%v, %v): shard %v has messages from logs %v, want %v",
+					tc.first, tc.end, tc.first+i, logIDs, want)
+			}
+		}
+	}
+}
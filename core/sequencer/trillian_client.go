@@ -120,10 +120,11 @@ func (c *MapWriteClient) GetLeavesByRevision(ctx context.Context, rev int64, ind
 	return mapLeaves.GetLeaves(), err
 }
 
-func (c *MapWriteClient) WriteLeaves(ctx context.Context, rev int64, leaves []*tpb.MapLeaf) error {
+func (c *MapWriteClient) WriteLeaves(ctx context.Context, rev int64, leaves []*tpb.MapLeaf, metadata []byte) error {
 	_, err := c.twrite.WriteLeaves(ctx, &tpb.WriteMapLeavesRequest{
 		MapId:          c.MapID,
 		Leaves:         leaves,
+		Metadata:       metadata,
 		ExpectRevision: rev,
 	})
 	return err
@@ -47,8 +47,11 @@ func ReadTranscript(testName string) (*tpb.Transcript, error) {
 	if err != nil {
 		return nil, err
 	}
-	transcriptFile := absPath
+	return ReadTranscriptFile(absPath)
+}
 
+// ReadTranscriptFile reads a transcript from a JSON file.
+func ReadTranscriptFile(transcriptFile string) (*tpb.Transcript, error) {
 	f, err := os.Open(transcriptFile)
 	if err != nil {
 		return nil, err
@@ -64,15 +67,20 @@ func ReadTranscript(testName string) (*tpb.Transcript, error) {
 
 // WriteTranscript saves the transcript to the testdata directory.
 func WriteTranscript(testName string, t *tpb.Transcript) error {
-	marshaler := &jsonpb.Marshaler{Indent: "\t"}
-
 	selfPath, err := packagePath()
 	if err != nil {
 		return err
 	}
 	// Output all key material needed to verify the test vectors.
 	testFile := path.Join(selfPath, fmt.Sprintf("%v.json", testName))
-	f, err := os.Create(testFile)
+	return WriteTranscriptFile(testFile, t)
+}
+
+// WriteTranscriptFile saves the transcript to a JSON file.
+func WriteTranscriptFile(transcriptFile string, t *tpb.Transcript) error {
+	marshaler := &jsonpb.Marshaler{Indent: "\t"}
+
+	f, err := os.Create(transcriptFile)
 	if err != nil {
 		return err
 	}
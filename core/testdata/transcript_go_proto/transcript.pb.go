@@ -87,6 +87,9 @@ type Action struct {
 	//	*Action_GetUser
 	//	*Action_BatchGetUser
 	//	*Action_BatchListUserRevisions
+	//	*Action_GetRevision
+	//	*Action_GetLatestRevision
+	//	*Action_ListUserRevisions
 	ReqRespPair          isAction_ReqRespPair `protobuf_oneof:"req_resp_pair"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
@@ -141,12 +144,30 @@ type Action_BatchListUserRevisions struct {
 	BatchListUserRevisions *BatchListUserRevisions `protobuf:"bytes,6,opt,name=batch_list_user_revisions,json=batchListUserRevisions,proto3,oneof"`
 }
 
+type Action_GetRevision struct {
+	GetRevision *GetRevision `protobuf:"bytes,7,opt,name=get_revision,json=getRevision,proto3,oneof"`
+}
+
+type Action_GetLatestRevision struct {
+	GetLatestRevision *GetLatestRevision `protobuf:"bytes,8,opt,name=get_latest_revision,json=getLatestRevision,proto3,oneof"`
+}
+
+type Action_ListUserRevisions struct {
+	ListUserRevisions *ListUserRevisions `protobuf:"bytes,9,opt,name=list_user_revisions,json=listUserRevisions,proto3,oneof"`
+}
+
 func (*Action_GetUser) isAction_ReqRespPair() {}
 
 func (*Action_BatchGetUser) isAction_ReqRespPair() {}
 
 func (*Action_BatchListUserRevisions) isAction_ReqRespPair() {}
 
+func (*Action_GetRevision) isAction_ReqRespPair() {}
+
+func (*Action_GetLatestRevision) isAction_ReqRespPair() {}
+
+func (*Action_ListUserRevisions) isAction_ReqRespPair() {}
+
 func (m *Action) GetReqRespPair() isAction_ReqRespPair {
 	if m != nil {
 		return m.ReqRespPair
@@ -175,12 +196,36 @@ func (m *Action) GetBatchListUserRevisions() *BatchListUserRevisions {
 	return nil
 }
 
+func (m *Action) GetGetRevision() *GetRevision {
+	if x, ok := m.GetReqRespPair().(*Action_GetRevision); ok {
+		return x.GetRevision
+	}
+	return nil
+}
+
+func (m *Action) GetGetLatestRevision() *GetLatestRevision {
+	if x, ok := m.GetReqRespPair().(*Action_GetLatestRevision); ok {
+		return x.GetLatestRevision
+	}
+	return nil
+}
+
+func (m *Action) GetListUserRevisions() *ListUserRevisions {
+	if x, ok := m.GetReqRespPair().(*Action_ListUserRevisions); ok {
+		return x.ListUserRevisions
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Action) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*Action_GetUser)(nil),
 		(*Action_BatchGetUser)(nil),
 		(*Action_BatchListUserRevisions)(nil),
+		(*Action_GetRevision)(nil),
+		(*Action_GetLatestRevision)(nil),
+		(*Action_ListUserRevisions)(nil),
 	}
 }
 
@@ -328,46 +373,201 @@ func (m *BatchListUserRevisions) GetResponse() *keytransparency_go_proto.BatchLi
 	return nil
 }
 
+// GetRevision request and response
+type GetRevision struct {
+	Request              *keytransparency_go_proto.GetRevisionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Response             *keytransparency_go_proto.Revision           `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *GetRevision) Reset()         { *m = GetRevision{} }
+func (m *GetRevision) String() string { return proto.CompactTextString(m) }
+func (*GetRevision) ProtoMessage()    {}
+func (*GetRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e496619a40363d8, []int{5}
+}
+
+func (m *GetRevision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRevision.Unmarshal(m, b)
+}
+func (m *GetRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRevision.Marshal(b, m, deterministic)
+}
+func (m *GetRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRevision.Merge(m, src)
+}
+func (m *GetRevision) XXX_Size() int {
+	return xxx_messageInfo_GetRevision.Size(m)
+}
+func (m *GetRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRevision proto.InternalMessageInfo
+
+func (m *GetRevision) GetRequest() *keytransparency_go_proto.GetRevisionRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *GetRevision) GetResponse() *keytransparency_go_proto.Revision {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+// GetLatestRevision request and response
+type GetLatestRevision struct {
+	Request              *keytransparency_go_proto.GetLatestRevisionRequest `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Response             *keytransparency_go_proto.Revision                 `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
+	XXX_unrecognized     []byte                                             `json:"-"`
+	XXX_sizecache        int32                                              `json:"-"`
+}
+
+func (m *GetLatestRevision) Reset()         { *m = GetLatestRevision{} }
+func (m *GetLatestRevision) String() string { return proto.CompactTextString(m) }
+func (*GetLatestRevision) ProtoMessage()    {}
+func (*GetLatestRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e496619a40363d8, []int{6}
+}
+
+func (m *GetLatestRevision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLatestRevision.Unmarshal(m, b)
+}
+func (m *GetLatestRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLatestRevision.Marshal(b, m, deterministic)
+}
+func (m *GetLatestRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLatestRevision.Merge(m, src)
+}
+func (m *GetLatestRevision) XXX_Size() int {
+	return xxx_messageInfo_GetLatestRevision.Size(m)
+}
+func (m *GetLatestRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLatestRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLatestRevision proto.InternalMessageInfo
+
+func (m *GetLatestRevision) GetRequest() *keytransparency_go_proto.GetLatestRevisionRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *GetLatestRevision) GetResponse() *keytransparency_go_proto.Revision {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+// ListUserRevisions request and response
+type ListUserRevisions struct {
+	Request              *keytransparency_go_proto.ListUserRevisionsRequest  `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	Response             *keytransparency_go_proto.ListUserRevisionsResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                            `json:"-"`
+	XXX_unrecognized     []byte                                              `json:"-"`
+	XXX_sizecache        int32                                               `json:"-"`
+}
+
+func (m *ListUserRevisions) Reset()         { *m = ListUserRevisions{} }
+func (m *ListUserRevisions) String() string { return proto.CompactTextString(m) }
+func (*ListUserRevisions) ProtoMessage()    {}
+func (*ListUserRevisions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e496619a40363d8, []int{7}
+}
+
+func (m *ListUserRevisions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUserRevisions.Unmarshal(m, b)
+}
+func (m *ListUserRevisions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUserRevisions.Marshal(b, m, deterministic)
+}
+func (m *ListUserRevisions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUserRevisions.Merge(m, src)
+}
+func (m *ListUserRevisions) XXX_Size() int {
+	return xxx_messageInfo_ListUserRevisions.Size(m)
+}
+func (m *ListUserRevisions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUserRevisions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUserRevisions proto.InternalMessageInfo
+
+func (m *ListUserRevisions) GetRequest() *keytransparency_go_proto.ListUserRevisionsRequest {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *ListUserRevisions) GetResponse() *keytransparency_go_proto.ListUserRevisionsResponse {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Transcript)(nil), "google.keytransparency.transcript.Transcript")
 	proto.RegisterType((*Action)(nil), "google.keytransparency.transcript.Action")
 	proto.RegisterType((*GetUser)(nil), "google.keytransparency.transcript.GetUser")
 	proto.RegisterType((*BatchGetUser)(nil), "google.keytransparency.transcript.BatchGetUser")
 	proto.RegisterType((*BatchListUserRevisions)(nil), "google.keytransparency.transcript.BatchListUserRevisions")
+	proto.RegisterType((*GetRevision)(nil), "google.keytransparency.transcript.GetRevision")
+	proto.RegisterType((*GetLatestRevision)(nil), "google.keytransparency.transcript.GetLatestRevision")
+	proto.RegisterType((*ListUserRevisions)(nil), "google.keytransparency.transcript.ListUserRevisions")
 }
 
 func init() { proto.RegisterFile("transcript.proto", fileDescriptor_6e496619a40363d8) }
 
 var fileDescriptor_6e496619a40363d8 = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x97, 0x36, 0xb4, 0xdd, 0xeb, 0x18, 0x95, 0x0f, 0x53, 0xd8, 0xa9, 0x54, 0x1c, 0xca,
-	0x0e, 0x8e, 0x3a, 0x2e, 0xec, 0x48, 0x0b, 0xac, 0x1a, 0x9c, 0x2c, 0x26, 0x24, 0x2e, 0x91, 0x93,
-	0x3e, 0x65, 0x16, 0x5b, 0x9c, 0xd9, 0x6e, 0xa4, 0x7e, 0x11, 0xbe, 0x01, 0x9f, 0x81, 0x0f, 0xc0,
-	0x47, 0xe0, 0x0b, 0xa1, 0xda, 0x4b, 0x5b, 0x25, 0x63, 0x8b, 0x76, 0xca, 0x8b, 0xdf, 0xfb, 0xff,
-	0xde, 0xdf, 0xcf, 0x96, 0x61, 0x60, 0x14, 0xcf, 0x74, 0xa2, 0x44, 0x6e, 0x68, 0xae, 0xa4, 0x91,
-	0xe4, 0x55, 0x2a, 0x65, 0x7a, 0x8d, 0xf4, 0x07, 0xae, 0x6c, 0x2e, 0xe7, 0x0a, 0xb3, 0x64, 0x45,
-	0xb7, 0x85, 0xc7, 0x87, 0xc5, 0x24, 0xe4, 0x8b, 0x1b, 0x91, 0x39, 0xc9, 0x71, 0x50, 0x4c, 0xc2,
-	0x6a, 0xb9, 0xcd, 0x8c, 0x7e, 0x7b, 0x00, 0x5f, 0x37, 0x42, 0x32, 0x84, 0xfe, 0x02, 0x5d, 0x2c,
-	0x64, 0x16, 0x78, 0x43, 0x6f, 0xbc, 0xcf, 0x76, 0x97, 0xc8, 0x14, 0xf6, 0x17, 0x42, 0x61, 0x62,
-	0xa4, 0x5a, 0x05, 0xad, 0xa1, 0x37, 0xee, 0x9f, 0xbe, 0xa6, 0xff, 0x71, 0x54, 0x4c, 0xe8, 0x87,
-	0xb2, 0x96, 0x6d, 0x65, 0x64, 0x06, 0x5d, 0x9e, 0xac, 0x69, 0x3a, 0x68, 0x0f, 0xdb, 0xe3, 0xfe,
-	0xe9, 0x1b, 0xfa, 0xe8, 0x9e, 0xe8, 0x7b, 0xab, 0x60, 0xa5, 0x72, 0xf4, 0xb7, 0x05, 0x1d, 0xb7,
-	0x46, 0x08, 0xf8, 0x6b, 0x8b, 0x77, 0x76, 0x6d, 0x4c, 0xce, 0xa1, 0x97, 0xa2, 0x89, 0x96, 0x1a,
-	0x55, 0xe0, 0x5b, 0x9b, 0x27, 0x0d, 0x9a, 0x9c, 0xa3, 0xb9, 0xd4, 0xa8, 0xe6, 0x7b, 0xac, 0x9b,
-	0xba, 0x90, 0x7c, 0x83, 0xc3, 0x98, 0x9b, 0xe4, 0x2a, 0xda, 0xe0, 0x9e, 0x59, 0x5c, 0xd8, 0x00,
-	0x37, 0x5d, 0x0b, 0xb7, 0xcc, 0x83, 0x78, 0xe7, 0x9f, 0x14, 0xf0, 0xd2, 0x81, 0xaf, 0x85, 0x76,
-	0xe4, 0x48, 0x61, 0x21, 0xb4, 0x9d, 0x4b, 0xc7, 0xf6, 0x38, 0x6b, 0xda, 0xe3, 0x8b, 0xd0, 0x16,
-	0xca, 0x4a, 0xc0, 0x7c, 0x8f, 0x1d, 0xc5, 0xf7, 0x66, 0xa6, 0x2f, 0xe0, 0xb9, 0xc2, 0xdb, 0x48,
-	0xa1, 0xce, 0xa3, 0x9c, 0x0b, 0x75, 0xe1, 0xf7, 0x5a, 0x83, 0xf6, 0x85, 0xdf, 0x6b, 0x0f, 0xfc,
-	0xd1, 0x4f, 0x0f, 0xba, 0xa5, 0xc1, 0x19, 0x74, 0x15, 0xde, 0x2e, 0x51, 0x1b, 0x3b, 0xd9, 0x07,
-	0x8e, 0xa9, 0x98, 0x94, 0x93, 0x63, 0x4e, 0xc0, 0x4a, 0x25, 0xf9, 0x04, 0xbd, 0x75, 0x27, 0x99,
-	0x69, 0xbc, 0xbb, 0x2e, 0x27, 0x4d, 0x28, 0x4e, 0xc1, 0x36, 0xda, 0xd1, 0x2f, 0x0f, 0x0e, 0x76,
-	0xc7, 0x49, 0xe6, 0x55, 0x77, 0xf4, 0x01, 0xee, 0xae, 0xb2, 0x66, 0xf1, 0x73, 0xcd, 0x62, 0xd8,
-	0x18, 0x55, 0xf3, 0xf9, 0xc7, 0x83, 0xa3, 0xfb, 0x8f, 0x84, 0xb0, 0xaa, 0xe3, 0x77, 0x8f, 0xb5,
-	0xa9, 0x31, 0x6a, 0xde, 0x2f, 0x6b, 0xde, 0xcf, 0x9e, 0x00, 0xad, 0xee, 0x62, 0xfa, 0xf1, 0xfb,
-	0x2c, 0x15, 0xe6, 0x6a, 0x19, 0xd3, 0x44, 0xde, 0x84, 0x0e, 0x58, 0x7d, 0x41, 0xc2, 0x44, 0x2a,
-	0x0c, 0x0d, 0x6a, 0xb3, 0xe0, 0x86, 0x87, 0xdb, 0x2b, 0x19, 0xa5, 0x32, 0xb2, 0xaf, 0x4b, 0xdc,
-	0xb1, 0x9f, 0xb7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x0c, 0x8d, 0xb7, 0xc5, 0x04, 0x00,
-	0x00,
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0xc6, 0x9b, 0x35, 0xf4, 0xcf, 0x69, 0x19, 0xad, 0x91, 0xa6, 0xb0, 0xab, 0x52, 0xb8, 0x28,
+	0x93, 0x48, 0xd4, 0x6d, 0x17, 0xec, 0x0a, 0xd1, 0x02, 0xad, 0xc6, 0x90, 0x90, 0x61, 0x42, 0xe2,
+	0x26, 0x4a, 0x53, 0x93, 0x45, 0x74, 0x75, 0x67, 0xbb, 0x91, 0xfa, 0x22, 0xf0, 0x00, 0x88, 0x37,
+	0x40, 0xe2, 0x01, 0x78, 0x29, 0x2e, 0x51, 0x9c, 0xa5, 0x09, 0x71, 0x49, 0x33, 0xc4, 0x55, 0x13,
+	0xdb, 0xdf, 0xef, 0xfb, 0x7c, 0x7c, 0xdc, 0x40, 0x4b, 0x30, 0x67, 0xce, 0x5d, 0xe6, 0x2f, 0x84,
+	0xb9, 0x60, 0x54, 0x50, 0x74, 0xdf, 0xa3, 0xd4, 0x9b, 0x11, 0xf3, 0x13, 0x59, 0xc9, 0xb9, 0x85,
+	0xc3, 0xc8, 0xdc, 0x5d, 0x99, 0xc9, 0xc2, 0xfd, 0xdd, 0xa0, 0x6f, 0x39, 0xd3, 0x4b, 0x7f, 0x1e,
+	0x49, 0xf6, 0x8d, 0xa0, 0x6f, 0x65, 0x97, 0xcb, 0x99, 0xee, 0x0f, 0x0d, 0xe0, 0xdd, 0x5a, 0x88,
+	0x3a, 0xd0, 0x98, 0x92, 0xe8, 0xd9, 0xa7, 0x73, 0x43, 0xeb, 0x68, 0xbd, 0x3a, 0x4e, 0x0f, 0xa1,
+	0x01, 0xd4, 0xa7, 0x3e, 0x23, 0xae, 0xa0, 0x6c, 0x65, 0xec, 0x74, 0xb4, 0x5e, 0xe3, 0xf0, 0xa1,
+	0xf9, 0x97, 0x44, 0x41, 0xdf, 0x7c, 0x1e, 0xaf, 0xc5, 0x89, 0x0c, 0x0d, 0xa1, 0xea, 0xb8, 0x21,
+	0x8d, 0x1b, 0xe5, 0x4e, 0xb9, 0xd7, 0x38, 0x7c, 0x64, 0x6e, 0xdd, 0x93, 0xf9, 0x4c, 0x2a, 0x70,
+	0xac, 0xec, 0xfe, 0xd2, 0xa1, 0x12, 0x8d, 0x21, 0x04, 0x7a, 0x18, 0xf1, 0x3a, 0xae, 0x7c, 0x46,
+	0x23, 0xa8, 0x79, 0x44, 0xd8, 0x4b, 0x4e, 0x98, 0xa1, 0xcb, 0x98, 0x07, 0x05, 0x4c, 0x46, 0x44,
+	0x9c, 0x73, 0xc2, 0xc6, 0x25, 0x5c, 0xf5, 0xa2, 0x47, 0xf4, 0x1e, 0x76, 0x27, 0x8e, 0x70, 0x2f,
+	0xec, 0x35, 0xee, 0x96, 0xc4, 0x59, 0x05, 0x70, 0x83, 0x50, 0x98, 0x30, 0x9b, 0x93, 0xd4, 0x3b,
+	0x0a, 0xe0, 0x5e, 0x04, 0x9e, 0xf9, 0x3c, 0x22, 0xdb, 0x8c, 0x04, 0x3e, 0x97, 0x75, 0xa9, 0x48,
+	0x8f, 0x93, 0xa2, 0x1e, 0x67, 0x3e, 0x97, 0x50, 0x1c, 0x03, 0xc6, 0x25, 0xbc, 0x37, 0xd9, 0x38,
+	0x83, 0xde, 0x42, 0x33, 0xdc, 0x4a, 0xec, 0x65, 0x54, 0xa5, 0x95, 0x59, 0xac, 0x3a, 0x31, 0x66,
+	0x5c, 0xc2, 0x0d, 0x2f, 0x79, 0x45, 0x1f, 0xe1, 0x6e, 0x08, 0x9d, 0x39, 0x82, 0xf0, 0x14, 0xbb,
+	0x26, 0xd9, 0xc7, 0xc5, 0xd8, 0x67, 0x52, 0x9c, 0x72, 0x68, 0x7b, 0xd9, 0xc1, 0xd0, 0x67, 0x53,
+	0xb9, 0xea, 0x85, 0x7d, 0x36, 0x55, 0xaa, 0x3d, 0xcb, 0x0e, 0x0e, 0xee, 0xc0, 0x6d, 0x46, 0xae,
+	0x6c, 0x46, 0xf8, 0xc2, 0x5e, 0x38, 0x3e, 0x3b, 0xd5, 0x6b, 0x3b, 0xad, 0xf2, 0xa9, 0x5e, 0x2b,
+	0xb7, 0xf4, 0xee, 0x67, 0x0d, 0xaa, 0xf1, 0x29, 0x0e, 0xa1, 0xca, 0xc8, 0xd5, 0x92, 0x70, 0x21,
+	0xdb, 0x2f, 0xa7, 0x97, 0x83, 0x7e, 0xdc, 0x5e, 0x38, 0x12, 0xe0, 0x58, 0x89, 0x5e, 0x42, 0x2d,
+	0x74, 0xa2, 0x73, 0x4e, 0xae, 0xef, 0xd4, 0x41, 0x11, 0x4a, 0xa4, 0xc0, 0x6b, 0x6d, 0xf7, 0x9b,
+	0x06, 0xcd, 0x74, 0xcf, 0xa1, 0x71, 0x36, 0x9d, 0x99, 0xc3, 0x4d, 0x2b, 0x95, 0x88, 0xaf, 0x94,
+	0x88, 0x56, 0x61, 0x94, 0x92, 0xf3, 0xa7, 0x06, 0x7b, 0x9b, 0xfb, 0x16, 0xe1, 0x6c, 0xe2, 0x27,
+	0xdb, 0x6c, 0x14, 0x86, 0x92, 0xfd, 0x5c, 0xc9, 0x7e, 0xf2, 0x0f, 0x50, 0x65, 0x17, 0x5f, 0x34,
+	0x68, 0xa4, 0xae, 0x04, 0x1a, 0x65, 0xa3, 0x3f, 0xce, 0x3f, 0xc4, 0x58, 0xa8, 0xe4, 0x7d, 0xaa,
+	0xe4, 0x7d, 0x90, 0x43, 0x5a, 0x63, 0x92, 0x64, 0x5f, 0x35, 0x68, 0x2b, 0x17, 0x0a, 0xbd, 0xce,
+	0xe6, 0x3b, 0xca, 0xcf, 0xf7, 0xa7, 0xfc, 0xff, 0xa7, 0xfc, 0xae, 0x41, 0x5b, 0x6d, 0x80, 0x1b,
+	0xa5, 0xdc, 0x7e, 0xf6, 0x6f, 0x94, 0x94, 0xc7, 0x37, 0xe3, 0x65, 0x8f, 0x7d, 0xf0, 0xe2, 0xc3,
+	0xd0, 0xf3, 0xc5, 0xc5, 0x72, 0x62, 0xba, 0xf4, 0xd2, 0x8a, 0x58, 0xd9, 0xaf, 0xab, 0xe5, 0x52,
+	0x46, 0xac, 0xb0, 0x7c, 0x53, 0x47, 0x38, 0x56, 0xf2, 0xff, 0x63, 0x7b, 0xd4, 0x96, 0x5f, 0xde,
+	0x49, 0x45, 0xfe, 0x1c, 0xfd, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x93, 0x7d, 0x35, 0xe1, 0x07,
+	0x00, 0x00,
 }
@@ -16,7 +16,6 @@ package integration
 
 import (
 	"context"
-	"database/sql"
 	"encoding/pem"
 	"fmt"
 	"net"
@@ -34,13 +33,15 @@ import (
 	"github.com/google/keytransparency/core/client"
 	"github.com/google/keytransparency/core/client/tracker"
 	"github.com/google/keytransparency/core/client/verifier"
+	"github.com/google/keytransparency/core/directory"
+	"github.com/google/keytransparency/core/fake"
 	"github.com/google/keytransparency/core/integration"
 	"github.com/google/keytransparency/core/keyserver"
-	"github.com/google/keytransparency/core/mutator/entry"
 	"github.com/google/keytransparency/core/sequencer"
+	"github.com/google/keytransparency/core/water"
 	"github.com/google/keytransparency/impl/authentication"
 	"github.com/google/keytransparency/impl/authorization"
-	"github.com/google/keytransparency/impl/mysql/directory"
+	"github.com/google/keytransparency/impl/memory"
 	"github.com/google/keytransparency/impl/mysql/mutationstorage"
 	"github.com/google/keytransparency/impl/mysql/testdb"
 	"github.com/google/trillian/crypto/keys/der"
@@ -49,6 +50,8 @@ import (
 
 	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
 	spb "github.com/google/keytransparency/core/sequencer/sequencer_go_proto"
+	mysqldir "github.com/google/keytransparency/impl/mysql/directory"
+	tpb "github.com/google/trillian"
 	tclient "github.com/google/trillian/client"
 	ttest "github.com/google/trillian/testonly/integration"
 
@@ -92,12 +95,29 @@ func Listen() (net.Listener, *grpc.ClientConn, error) {
 // Env holds a complete testing environment for end-to-end tests.
 type Env struct {
 	*integration.Env
-	mapEnv     *ttest.MapEnv
-	logEnv     *ttest.LogEnv
 	admin      *adminserver.Server
 	grpcServer *grpc.Server
 	grpcCC     *grpc.ClientConn
-	db         *sql.DB
+	// closers release the backends of the environment.
+	closers []func()
+}
+
+// trillianClients holds the clients of the Trillian servers that back an Env.
+type trillianClients struct {
+	log      tpb.TrillianLogClient
+	tmap     tpb.TrillianMapClient
+	mapWrite tpb.TrillianMapWriteClient
+	logAdmin tpb.TrillianAdminClient
+	mapAdmin tpb.TrillianAdminClient
+}
+
+// mutationLogs stores the input logs of mutations.
+type mutationLogs interface {
+	keyserver.MutationLogs
+	AddLogs(ctx context.Context, directoryID string, logIDs ...int64) error
+	SetWritable(ctx context.Context, directoryID string, logID int64, enabled bool) error
+	HighWatermark(ctx context.Context, directoryID string, logID int64,
+		start water.Mark, batchSize int32) (int32, water.Mark, error)
 }
 
 func vrfKeyGen(ctx context.Context, spec *keyspb.Specification) (proto.Message, error) {
@@ -117,9 +137,6 @@ func keyFromPEM(p string) *any.Any {
 // NewEnv sets up common resources for tests.
 func NewEnv(ctx context.Context, t testing.TB) *Env {
 	t.Helper()
-	timeout := 6 * time.Second
-	directoryID := "integration"
-
 	db := testdb.NewForTest(ctx, t)
 
 	// Map server
@@ -136,8 +153,7 @@ func NewEnv(ctx context.Context, t testing.TB) *Env {
 		t.Fatalf("env: failed to create trillian log server: %v", err)
 	}
 
-	// Configure directory, which creates new map and log trees.
-	directoryStorage, err := directory.NewStorage(db)
+	directoryStorage, err := mysqldir.NewStorage(db)
 	if err != nil {
 		t.Fatalf("env: failed to create directory storage: %v", err)
 	}
@@ -145,7 +161,43 @@ func NewEnv(ctx context.Context, t testing.TB) *Env {
 	if err != nil {
 		t.Fatalf("env: Failed to create mutations object: %v", err)
 	}
-	adminSvr := adminserver.New(logEnv.Log, mapEnv.Map, logEnv.Admin, mapEnv.Admin, directoryStorage, mutations, mutations, vrfKeyGen)
+	env := newEnv(ctx, t, trillianClients{
+		log:      logEnv.Log,
+		tmap:     mapEnv.Map,
+		mapWrite: mapEnv.Write,
+		logAdmin: logEnv.Admin,
+		mapAdmin: mapEnv.Admin,
+	}, directoryStorage, mutations, mutations, mutations)
+	env.closers = append(env.closers, mapEnv.Close, logEnv.Close, func() { db.Close() })
+	return env
+}
+
+// NewInMemoryEnv sets up a testing environment that runs entirely in the
+// test process. Trillian is replaced by fake.Trillian and storage is kept in
+// memory, so no database or external servers are needed.
+func NewInMemoryEnv(ctx context.Context, t testing.TB) *Env {
+	t.Helper()
+	ft := fake.NewTrillian()
+	return newEnv(ctx, t, trillianClients{
+		log:      ft.Log(),
+		tmap:     ft.Map(),
+		mapWrite: ft.MapWrite(),
+		logAdmin: ft.Admin(),
+		mapAdmin: ft.Admin(),
+	}, memory.NewDirectoryStorage(), memory.NewMutationLogs(), memory.NewBatches(), memory.NewExpiries())
+}
+
+// newEnv creates a directory and starts Key Transparency servers that use
+// the given Trillian servers and storage.
+func newEnv(ctx context.Context, t testing.TB, tc trillianClients,
+	directoryStorage directory.Storage, logs mutationLogs, batches sequencer.Batcher,
+	expiries sequencer.Expiries) *Env {
+	t.Helper()
+	timeout := 6 * time.Second
+	directoryID := "integration"
+
+	// Configure directory, which creates new map and log trees.
+	adminSvr := adminserver.New(tc.log, tc.tmap, tc.logAdmin, tc.mapAdmin, directoryStorage, logs, batches, vrfKeyGen)
 	cctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 	directoryPB, err := adminSvr.CreateDirectory(cctx, &pb.CreateDirectoryRequest{
@@ -181,20 +233,25 @@ func NewEnv(ctx context.Context, t testing.TB) *Env {
 	)
 
 	pb.RegisterKeyTransparencyServer(gsvr, keyserver.New(
-		logEnv.Log, mapEnv.Map,
-		entry.IsValidEntry, directoryStorage,
-		mutations, mutations,
+		tc.log, tc.tmap,
+		directoryStorage,
+		logs, batches,
 		monitoring.InertMetricFactory{},
 		10, /*Revisions per page */
 	))
 
-	spb.RegisterKeyTransparencySequencerServer(gsvr, sequencer.NewServer(
+	sequencerServer := sequencer.NewServer(
 		directoryStorage,
-		logEnv.Log, mapEnv.Map, mapEnv.Write,
-		mutations, mutations,
+		tc.log, tc.tmap, tc.mapWrite,
+		batches, expiries, logs,
 		spb.NewKeyTransparencySequencerClient(cc),
 		monitoring.InertMetricFactory{},
-	))
+	)
+	// Exercise sharded revision building, with the shards processed in two
+	// groups.
+	sequencerServer.ApplyRevisionShards = 4
+	sequencerServer.ApplyRevisionParallelism = 2
+	spb.RegisterKeyTransparencySequencerServer(gsvr, sequencerServer)
 
 	go gsvr.Serve(lis)
 
@@ -218,16 +275,13 @@ func NewEnv(ctx context.Context, t testing.TB) *Env {
 				return []grpc.CallOption{grpc.PerRPCCredentials(authentication.GetFakeCredential(userID))}
 			},
 		},
-		mapEnv:     mapEnv,
-		logEnv:     logEnv,
 		admin:      adminSvr,
 		grpcServer: gsvr,
 		grpcCC:     cc,
-		db:         db,
 	}
 }
 
-// Close releases resources allocated by NewEnv.
+// Close releases resources allocated by NewEnv or NewInMemoryEnv.
 func (env *Env) Close() {
 	ctx := context.Background()
 	if _, err := env.admin.DeleteDirectory(ctx, &pb.DeleteDirectoryRequest{
@@ -237,7 +291,7 @@ func (env *Env) Close() {
 	}
 	env.grpcCC.Close()
 	env.grpcServer.Stop()
-	env.mapEnv.Close()
-	env.logEnv.Close()
-	env.db.Close()
+	for _, c := range env.closers {
+		c()
+	}
 }
@@ -32,11 +32,21 @@ var generate = flag.Bool("generate", false, "Defines if test vectors should be g
 func TestIntegration(t *testing.T) {
 	// We can only run the integration tests if there is a MySQL instance available.
 	testdb.SkipIfNoMySQL(t)
+	runAllTests(t, NewEnv)
+}
+
+// TestInMemoryIntegration runs all KeyTransparency integration tests
+// against fake Trillian servers and in-memory storage.
+func TestInMemoryIntegration(t *testing.T) {
+	runAllTests(t, NewInMemoryEnv)
+}
+
+func runAllTests(t *testing.T, newEnv func(context.Context, testing.TB) *Env) {
 	for _, test := range integration.AllTests {
 		t.Run(test.Name, func(t *testing.T) {
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
-			env := NewEnv(ctx, t)
+			env := newEnv(ctx, t)
 			defer env.Close()
 			cctx, cancel := context.WithCancel(ctx)
 			actions := test.Fn(cctx, env.Env, t)
@@ -0,0 +1,79 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	spb "github.com/google/keytransparency/core/sequencer/sequencer_go_proto"
+)
+
+// Batches is an in-memory implementation of sequencer.Batcher.
+// Batches is safe for concurrent use.
+type Batches struct {
+	mu      sync.RWMutex
+	batches map[string]map[int64]*spb.MapMetadata // Map of directoryID to revision to batch.
+}
+
+// NewBatches returns an empty Batches.
+func NewBatches() *Batches {
+	return &Batches{
+		batches: make(map[string]map[int64]*spb.MapMetadata),
+	}
+}
+
+// WriteBatchSources saves the (low, high] boundaries used for each log in making this revision.
+// If rev has already been defined, WriteBatchSources returns an error.
+func (b *Batches) WriteBatchSources(_ context.Context, dirID string, rev int64, meta *spb.MapMetadata) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.batches[dirID][rev]; ok {
+		return status.Errorf(codes.AlreadyExists, "revision %v already defined", rev)
+	}
+	if b.batches[dirID] == nil {
+		b.batches[dirID] = make(map[int64]*spb.MapMetadata)
+	}
+	b.batches[dirID][rev] = proto.Clone(meta).(*spb.MapMetadata)
+	return nil
+}
+
+// ReadBatch returns the batch definitions for a given revision.
+func (b *Batches) ReadBatch(_ context.Context, dirID string, rev int64) (*spb.MapMetadata, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	meta, ok := b.batches[dirID][rev]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "revision %v not found", rev)
+	}
+	return proto.Clone(meta).(*spb.MapMetadata), nil
+}
+
+// HighestRev returns the highest defined revision number for dirID.
+func (b *Batches) HighestRev(_ context.Context, dirID string) (int64, error) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	var highest int64
+	for rev := range b.batches[dirID] {
+		if rev > highest {
+			highest = rev
+		}
+	}
+	return highest, nil
+}
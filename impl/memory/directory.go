@@ -0,0 +1,119 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/keytransparency/core/directory"
+	"github.com/google/trillian/crypto/keyspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tpb "github.com/google/trillian"
+)
+
+// DirectoryStorage is an in-memory implementation of directory.Storage.
+// DirectoryStorage is safe for concurrent use.
+type DirectoryStorage struct {
+	mu          sync.RWMutex
+	directories map[string]*directory.Directory
+}
+
+// NewDirectoryStorage returns an empty DirectoryStorage.
+func NewDirectoryStorage() *DirectoryStorage {
+	return &DirectoryStorage{
+		directories: make(map[string]*directory.Directory),
+	}
+}
+
+// clone returns a deep copy of d so that callers can't modify stored values.
+func clone(d *directory.Directory) *directory.Directory {
+	c := *d
+	c.Map = proto.Clone(d.Map).(*tpb.Tree)
+	c.Log = proto.Clone(d.Log).(*tpb.Tree)
+	c.VRF = proto.Clone(d.VRF).(*keyspb.PublicKey)
+	if d.VRFPriv != nil {
+		c.VRFPriv = proto.Clone(d.VRFPriv)
+	}
+	c.AdminKeyset = append([]byte(nil), d.AdminKeyset...)
+	return &c
+}
+
+// List returns the directories sorted by ID. Deleted directories are only
+// returned if showDeleted is true.
+func (s *DirectoryStorage) List(_ context.Context, showDeleted bool) ([]*directory.Directory, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ret := []*directory.Directory{}
+	for _, d := range s.directories {
+		if d.Deleted && !showDeleted {
+			continue
+		}
+		ret = append(ret, clone(d))
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].DirectoryID < ret[j].DirectoryID })
+	return ret, nil
+}
+
+// Write stores a new directory.
+func (s *DirectoryStorage) Write(_ context.Context, d *directory.Directory) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.directories[d.DirectoryID]; ok {
+		return status.Errorf(codes.AlreadyExists, "directory %v already exists", d.DirectoryID)
+	}
+	s.directories[d.DirectoryID] = clone(d)
+	return nil
+}
+
+// Read returns the directory with directoryID.
+func (s *DirectoryStorage) Read(_ context.Context, directoryID string, showDeleted bool) (*directory.Directory, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	d, ok := s.directories[directoryID]
+	if !ok || d.Deleted && !showDeleted {
+		return nil, status.Errorf(codes.NotFound, "directory %v not found", directoryID)
+	}
+	return clone(d), nil
+}
+
+// SetDelete soft deletes or undeletes a directory.
+func (s *DirectoryStorage) SetDelete(_ context.Context, directoryID string, isDeleted bool) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d, ok := s.directories[directoryID]
+	if !ok {
+		return status.Errorf(codes.NotFound, "directory %v not found", directoryID)
+	}
+	d.Deleted = isDeleted
+	d.DeletedTimestamp = time.Now()
+	return nil
+}
+
+// Delete permanently deletes a directory.
+func (s *DirectoryStorage) Delete(_ context.Context, directoryID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.directories[directoryID]; !ok {
+		return status.Errorf(codes.NotFound, "directory %v not found", directoryID)
+	}
+	delete(s.directories, directoryID)
+	return nil
+}
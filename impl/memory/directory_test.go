@@ -0,0 +1,138 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/keytransparency/core/directory"
+	"github.com/google/trillian/crypto/keyspb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tpb "github.com/google/trillian"
+)
+
+func newDirectory(directoryID string) *directory.Directory {
+	return &directory.Directory{
+		DirectoryID: directoryID,
+		Map:         &tpb.Tree{TreeId: 1},
+		Log:         &tpb.Tree{TreeId: 2},
+		VRF:         &keyspb.PublicKey{Der: []byte("pubkeybytes")},
+		VRFPriv:     &keyspb.PrivateKey{Der: []byte("privkeybytes")},
+		MinInterval: 1 * time.Second,
+		MaxInterval: 5 * time.Second,
+	}
+}
+
+func TestDirectoryList(t *testing.T) {
+	ctx := context.Background()
+	s := NewDirectoryStorage()
+	for _, id := range []string{"directory2", "directory1", "deleted"} {
+		if err := s.Write(ctx, newDirectory(id)); err != nil {
+			t.Fatalf("Write(%v): %v", id, err)
+		}
+	}
+	if err := s.SetDelete(ctx, "deleted", true); err != nil {
+		t.Fatalf("SetDelete(): %v", err)
+	}
+	for _, tc := range []struct {
+		showDeleted bool
+		want        []string
+	}{
+		{showDeleted: false, want: []string{"directory1", "directory2"}},
+		{showDeleted: true, want: []string{"deleted", "directory1", "directory2"}},
+	} {
+		directories, err := s.List(ctx, tc.showDeleted)
+		if err != nil {
+			t.Fatalf("List(): %v", err)
+		}
+		got := make([]string, 0, len(directories))
+		for _, d := range directories {
+			got = append(got, d.DirectoryID)
+		}
+		if !cmp.Equal(got, tc.want) {
+			t.Errorf("List(%v): %v, want %v", tc.showDeleted, got, tc.want)
+		}
+	}
+}
+
+func TestDirectoryWriteReadDelete(t *testing.T) {
+	ctx := context.Background()
+	s := NewDirectoryStorage()
+	d := newDirectory("testdirectory")
+	if err := s.Write(ctx, d); err != nil {
+		t.Fatalf("Write(): %v", err)
+	}
+	if err := s.Write(ctx, d); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("Write(duplicate): %v, want %v", err, codes.AlreadyExists)
+	}
+
+	// Modifying the returned value must not change the stored directory.
+	got, err := s.Read(ctx, d.DirectoryID, false)
+	if err != nil {
+		t.Fatalf("Read(): %v", err)
+	}
+	got.Map.TreeId = 100
+	if got, err := s.Read(ctx, d.DirectoryID, false); err != nil {
+		t.Fatalf("Read(): %v", err)
+	} else if !cmp.Equal(got, d, cmp.Comparer(proto.Equal)) {
+		t.Errorf("Read(): %#v, want %#v", got, d)
+	}
+
+	for _, tc := range []struct {
+		desc                   string
+		isDeleted, readDeleted bool
+		wantCode               codes.Code
+	}{
+		{desc: "delete", isDeleted: true, readDeleted: false, wantCode: codes.NotFound},
+		{desc: "read deleted", isDeleted: true, readDeleted: true},
+		{desc: "undelete", isDeleted: false, readDeleted: false},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			if err := s.SetDelete(ctx, d.DirectoryID, tc.isDeleted); err != nil {
+				t.Fatalf("SetDelete(%v): %v", tc.isDeleted, err)
+			}
+			got, err := s.Read(ctx, d.DirectoryID, tc.readDeleted)
+			if status.Code(err) != tc.wantCode {
+				t.Fatalf("Read(): %v, want %v", err, tc.wantCode)
+			}
+			if err != nil {
+				return
+			}
+			want := *d
+			want.Deleted = tc.isDeleted
+			if !cmp.Equal(got, &want, cmp.Comparer(proto.Equal),
+				cmpopts.IgnoreFields(directory.Directory{}, "DeletedTimestamp")) {
+				t.Errorf("Read(): %#v, want %#v", got, want)
+			}
+		})
+	}
+
+	if err := s.Delete(ctx, d.DirectoryID); err != nil {
+		t.Fatalf("Delete(): %v", err)
+	}
+	if _, err := s.Read(ctx, d.DirectoryID, true); status.Code(err) != codes.NotFound {
+		t.Errorf("Read(): %v, want %v", err, codes.NotFound)
+	}
+	if err := s.Delete(ctx, d.DirectoryID); status.Code(err) != codes.NotFound {
+		t.Errorf("Delete(): %v, want %v", err, codes.NotFound)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package memory
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/golang/protobuf/ptypes"
+
+	spb "github.com/google/keytransparency/core/sequencer/sequencer_go_proto"
+)
+
+// Expiries is an in-memory implementation of sequencer.Expiries.
+// Expiries is safe for concurrent use.
+type Expiries struct {
+	mu       sync.RWMutex
+	expiries map[string]map[string]*spb.RevisionMetadata_Expiry // Map of directoryID to index to expiry.
+}
+
+// NewExpiries returns an empty Expiries.
+func NewExpiries() *Expiries {
+	return &Expiries{
+		expiries: make(map[string]map[string]*spb.RevisionMetadata_Expiry),
+	}
+}
+
+// WriteExpiries sets the not_after time of the entry at each index. An expiry
+// without a not_after time clears the expiry of its entry.
+func (e *Expiries) WriteExpiries(_ context.Context, dirID string, expiries []*spb.RevisionMetadata_Expiry) error {
+	for _, x := range expiries {
+		if x.NotAfter == nil {
+			continue
+		}
+		if _, err := ptypes.Timestamp(x.NotAfter); err != nil {
+			return err
+		}
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.expiries[dirID] == nil {
+		e.expiries[dirID] = make(map[string]*spb.RevisionMetadata_Expiry)
+	}
+	for _, x := range expiries {
+		if x.NotAfter == nil {
+			delete(e.expiries[dirID], string(x.Index))
+			continue
+		}
+		e.expiries[dirID][string(x.Index)] = proto.Clone(x).(*spb.RevisionMetadata_Expiry)
+	}
+	return nil
+}
+
+// ReadExpired returns the expiries of dirID with a not_after time in
+// [start, end), ordered by index.
+func (e *Expiries) ReadExpired(_ context.Context, dirID string, start, end time.Time) ([]*spb.RevisionMetadata_Expiry, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	var ret []*spb.RevisionMetadata_Expiry
+	for _, x := range e.expiries[dirID] {
+		notAfter, err := ptypes.Timestamp(x.NotAfter)
+		if err != nil {
+			return nil, err
+		}
+		if !notAfter.Before(start) && notAfter.Before(end) {
+			ret = append(ret, proto.Clone(x).(*spb.RevisionMetadata_Expiry))
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return bytes.Compare(ret[i].Index, ret[j].Index) < 0 })
+	return ret, nil
+}
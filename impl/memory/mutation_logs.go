@@ -12,100 +12,154 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package memory supplies fake in-memory implementations for testing purposes.
+// Package memory supplies in-memory storage implementations for testing and
+// local development. Nothing is persisted across restarts.
 package memory
 
 import (
 	"context"
 	"fmt"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/google/keytransparency/core/mutator"
 	"github.com/google/keytransparency/core/water"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
 )
 
-var clock = uint64(10) // Start logical clock at an arbitrary, non-zero place.
-
-// NewMutationLogs creates a new fake MutationLogs.
-func NewMutationLogs() MutationLogs {
-	return make(MutationLogs)
-}
-
 type batch struct {
 	wm   water.Mark
 	msgs []*mutator.LogMessage
 }
 
-// MutationLogs is a fake, in-memory implementation of a keyserver.MutationLogss.
-// All requests are presumed to be for the same domainID.
-// TODO(gbelvin): Support multiple domainIDs, if tests call for it.
-// MutationLogs is NOT threadsafe.
-type MutationLogs map[int64][]batch // Map of logID to Slice of LogMessages
+type mutationLog struct {
+	writable bool
+	batches  []batch
+}
+
+// MutationLogs is an in-memory implementation of keyserver.MutationLogs,
+// sequencer.LogsReader and adminserver.LogsAdmin.
+// MutationLogs is safe for concurrent use.
+type MutationLogs struct {
+	mu    sync.RWMutex
+	clock uint64                            // Logical clock used to assign watermarks.
+	logs  map[string]map[int64]*mutationLog // Map of directoryID to logID to log.
+}
+
+// NewMutationLogs creates a new fake MutationLogs.
+func NewMutationLogs() *MutationLogs {
+	return &MutationLogs{
+		clock: 10, // Start logical clock at an arbitrary, non-zero place.
+		logs:  make(map[string]map[int64]*mutationLog),
+	}
+}
+
+// log returns logID in directoryID. Callers must hold m.mu.
+func (m *MutationLogs) log(directoryID string, logID int64) (*mutationLog, bool) {
+	l, ok := m.logs[directoryID][logID]
+	return l, ok
+}
 
 // AddLogs adds logIDs to the mutation database.
-func (m MutationLogs) AddLogs(_ context.Context, _ string, logIDs ...int64) error {
+func (m *MutationLogs) AddLogs(_ context.Context, directoryID string, logIDs ...int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.logs[directoryID] == nil {
+		m.logs[directoryID] = make(map[int64]*mutationLog)
+	}
 	for _, logID := range logIDs {
-		m[logID] = make([]batch, 0)
+		m.logs[directoryID][logID] = &mutationLog{writable: true}
+	}
+	return nil
+}
+
+// SetWritable enables or disables new writes from going to logID.
+func (m *MutationLogs) SetWritable(_ context.Context, directoryID string, logID int64, enabled bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	l, ok := m.log(directoryID, logID)
+	if !ok {
+		return status.Errorf(codes.NotFound, "log %d for directory %v not found", logID, directoryID)
 	}
+	l.writable = enabled
 	return nil
 }
 
 // ListLogs returns a sorted list of logIDs.
-func (m MutationLogs) ListLogs(_ context.Context, _ string, writable bool) ([]int64, error) {
+// If writable is true, only logs that accept new writes are returned.
+func (m *MutationLogs) ListLogs(_ context.Context, directoryID string, writable bool) ([]int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
 	logIDs := []int64{}
-	for logID := range m {
+	for logID, l := range m.logs[directoryID] {
+		if writable && !l.writable {
+			continue
+		}
 		logIDs = append(logIDs, logID)
 	}
+	if len(logIDs) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no log found for directory %v", directoryID)
+	}
 	sort.Slice(logIDs, func(a, b int) bool { return logIDs[a] < logIDs[b] })
 	return logIDs, nil
 }
 
 // SendBatch stores a batch of mutations in a given logID.
-func (m MutationLogs) SendBatch(_ context.Context, _ string, logID int64, mutations []*pb.EntryUpdate) (water.Mark, error) {
-	wm := water.NewMark(clock)
-	clock++
-	// Only save the Merkle tree bits.
-	entries := make([]*pb.SignedEntry, 0, len(mutations))
-	for _, i := range mutations {
-		entries = append(entries, i.Mutation)
+func (m *MutationLogs) SendBatch(_ context.Context, directoryID string, logID int64, mutations []*pb.EntryUpdate) (water.Mark, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	wm := water.NewMark(m.clock)
+	m.clock++
+
+	l, ok := m.log(directoryID, logID)
+	if !ok {
+		// Logs that have not been added explicitly are created on first use.
+		if m.logs[directoryID] == nil {
+			m.logs[directoryID] = make(map[int64]*mutationLog)
+		}
+		l = &mutationLog{writable: true}
+		m.logs[directoryID][logID] = l
 	}
-
-	logShard := m[logID]
-	if len(logShard) > 0 && logShard[len(logShard)-1].wm.Compare(wm) > 0 {
+	if n := len(l.batches); n > 0 && l.batches[n-1].wm.Compare(wm) > 0 {
 		return water.Mark{}, fmt.Errorf("inserting mutation entry %v out of order", wm)
 	}
 
-	// Convert []SignedEntry into []LogMessage for storage.
-	msgs := make([]*mutator.LogMessage, 0, len(entries))
-	for i, e := range entries {
-		m := &mutator.LogMessage{
+	// Convert []EntryUpdate into []LogMessage for storage.
+	msgs := make([]*mutator.LogMessage, 0, len(mutations))
+	for i, e := range mutations {
+		msgs = append(msgs, &mutator.LogMessage{
 			LogID:     logID,
 			ID:        wm,
 			LocalID:   int64(i),
 			CreatedAt: time.Now(),
-			Mutation:  e,
-		}
-		msgs = append(msgs, m)
+			Mutation:  e.Mutation,
+			ExtraData: e.Committed,
+		})
 	}
-	m[logID] = append(logShard, batch{wm: wm, msgs: msgs})
+	l.batches = append(l.batches, batch{wm: wm, msgs: msgs})
 	return wm, nil
 }
 
 // ReadLog returns mutations between [low, high).  Always returns complete batches.
 // ReadLog will return more items than batchSize if necessary to return a complete batch.
-func (m MutationLogs) ReadLog(_ context.Context, _ string,
+func (m *MutationLogs) ReadLog(_ context.Context, directoryID string,
 	logID int64, low, high water.Mark, batchSize int32) ([]*mutator.LogMessage, error) {
-	logShard := m[logID]
-	if len(logShard) == 0 || batchSize == 0 {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	l, ok := m.log(directoryID, logID)
+	if !ok || len(l.batches) == 0 || batchSize == 0 {
 		return nil, nil
 	}
+	logShard := l.batches
 	start := sort.Search(len(logShard), func(i int) bool { return logShard[i].wm.Compare(low) >= 0 })
 	end := sort.Search(len(logShard), func(i int) bool { return logShard[i].wm.Compare(high) >= 0 })
 	// If the search is unsuccessful, i will be equal to len(logShard).
-	if start == len(logShard) && logShard[start-1].wm.Compare(low) < 0 {
+	// An empty range, such as [low, low), is valid and returns no items.
+	if start == len(logShard) && logShard[start-1].wm.Compare(low) < 0 && low.Compare(high) < 0 {
 		return nil, fmt.Errorf("invalid argument: low: %v, want <= max watermark: %v", low, logShard[start-1].wm)
 	}
 	out := make([]*mutator.LogMessage, 0, batchSize)
@@ -119,9 +173,14 @@ func (m MutationLogs) ReadLog(_ context.Context, _ string,
 }
 
 // HighWatermark returns the highest watermark batchSize items beyond start.
-func (m MutationLogs) HighWatermark(_ context.Context, _ string, logID int64, start water.Mark,
+func (m *MutationLogs) HighWatermark(_ context.Context, directoryID string, logID int64, start water.Mark,
 	batchSize int32) (int32, water.Mark, error) {
-	logShard := m[logID]
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var logShard []batch
+	if l, ok := m.log(directoryID, logID); ok {
+		logShard = l.batches
+	}
 	i := sort.Search(len(logShard), func(i int) bool { return logShard[i].wm.Compare(start) >= 0 })
 
 	count := int32(0)
@@ -18,6 +18,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/keytransparency/core/adminserver"
 	"github.com/google/keytransparency/core/integration/storagetest"
 	"github.com/google/keytransparency/core/keyserver"
 )
@@ -44,3 +45,14 @@ func TestMutationLogsReaderIntegration(t *testing.T) {
 			return m
 		})
 }
+
+func TestLogsAdminIntegration(t *testing.T) {
+	storagetest.RunLogsAdminTests(t,
+		func(ctx context.Context, t *testing.T, dirID string, logIDs ...int64) adminserver.LogsAdmin {
+			m := NewMutationLogs()
+			if err := m.AddLogs(ctx, dirID, logIDs...); err != nil {
+				t.Fatal(err)
+			}
+			return m
+		})
+}
@@ -32,3 +32,12 @@ func IsDeadlock(err error) bool {
 	}
 	return false
 }
+
+// IsDuplicateEntry returns true if the error is a MySQL duplicate key error.
+func IsDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		return mysqlErr.Number == mysqlerr.ER_DUP_ENTRY
+	}
+	return false
+}
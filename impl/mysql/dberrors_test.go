@@ -41,3 +41,20 @@ func TestIsDeadlock(t *testing.T) {
 		}
 	}
 }
+
+func TestIsDuplicateEntry(t *testing.T) {
+	for _, test := range []struct {
+		err  error
+		want bool
+	}{
+		{want: false, err: nil},
+		{want: false, err: fmt.Errorf("foobar")},
+		{want: false, err: &mysql.MySQLError{Number: 1213, Message: "deadlock"}},
+		{want: true, err: &mysql.MySQLError{Number: 1062, Message: "duplicate entry"}},
+		{want: true, err: fmt.Errorf("wrapped: %w", &mysql.MySQLError{Number: 1062, Message: "duplicate entry"})},
+	} {
+		if got := IsDuplicateEntry(test.err); got != test.want {
+			t.Errorf("IsDuplicateEntry(%v): %v, want %v", test.err, got, test.want)
+		}
+	}
+}
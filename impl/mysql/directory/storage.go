@@ -41,29 +41,44 @@ CREATE TABLE IF NOT EXISTS Directories(
   VRFPrivateKey         MEDIUMBLOB NOT NULL,
   MinInterval           BIGINT NOT NULL,
   MaxInterval           BIGINT NOT NULL,
+  Mutator               VARCHAR(100) NOT NULL DEFAULT '',
+  AllowReregistration   INTEGER NOT NULL DEFAULT 0,
+  AdminKeyset           MEDIUMBLOB,
   Deleted               INTEGER,
   DeleteTimeSeconds      BIGINT,
   PRIMARY KEY(DirectoryId)
 );`
 	writeSQL = `INSERT INTO Directories
-(DirectoryId, Map, Log, VRFPublicKey, VRFPrivateKey, MinInterval, MaxInterval, Deleted, DeleteTimeSeconds)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?);`
+(DirectoryId, Map, Log, VRFPublicKey, VRFPrivateKey, MinInterval, MaxInterval, Mutator, AllowReregistration, AdminKeyset, Deleted, DeleteTimeSeconds)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`
 	readSQL = `
-SELECT DirectoryId, Map, Log, VRFPublicKey, VRFPrivateKey, MinInterval, MaxInterval, Deleted, DeleteTimeSeconds
+SELECT DirectoryId, Map, Log, VRFPublicKey, VRFPrivateKey, MinInterval, MaxInterval, Mutator, AllowReregistration, AdminKeyset, Deleted, DeleteTimeSeconds
 FROM Directories WHERE DirectoryId = ? AND Deleted = 0;`
 	readDeletedSQL = `
-SELECT DirectoryId, Map, Log, VRFPublicKey, VRFPrivateKey, MinInterval, MaxInterval, Deleted, DeleteTimeSeconds
+SELECT DirectoryId, Map, Log, VRFPublicKey, VRFPrivateKey, MinInterval, MaxInterval, Mutator, AllowReregistration, AdminKeyset, Deleted, DeleteTimeSeconds
 FROM Directories WHERE DirectoryId = ?;`
 	listSQL = `
-SELECT DirectoryId, Map, Log, VRFPublicKey, VRFPrivateKey, MinInterval, MaxInterval, Deleted
+SELECT DirectoryId, Map, Log, VRFPublicKey, VRFPrivateKey, MinInterval, MaxInterval, Mutator, AllowReregistration, AdminKeyset, Deleted
 FROM Directories WHERE Deleted = 0;`
 	listDeletedSQL = `
-SELECT DirectoryId, Map, Log, VRFPublicKey, VRFPrivateKey, MinInterval, MaxInterval, Deleted
+SELECT DirectoryId, Map, Log, VRFPublicKey, VRFPrivateKey, MinInterval, MaxInterval, Mutator, AllowReregistration, AdminKeyset, Deleted
 FROM Directories;`
-	setDeletedSQL = `UPDATE Directories SET Deleted = ?, DeleteTimeSeconds = ? WHERE DirectoryId = ?`
-	deleteSQL     = `DELETE FROM Directories WHERE DirectoryId = ?`
+	setDeletedSQL   = `UPDATE Directories SET Deleted = ?, DeleteTimeSeconds = ? WHERE DirectoryId = ?`
+	deleteSQL       = `DELETE FROM Directories WHERE DirectoryId = ?`
+	columnExistsSQL = `
+SELECT COUNT(*) FROM information_schema.COLUMNS
+WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = 'Directories' AND COLUMN_NAME = ?;`
 )
 
+// addedColumns are the columns that were added to the Directories table after
+// it was first released. Their defaults preserve the behavior of directories
+// that were created before the column existed.
+var addedColumns = []struct{ name, definition string }{
+	{name: "Mutator", definition: "VARCHAR(100) NOT NULL DEFAULT ''"},
+	{name: "AllowReregistration", definition: "INTEGER NOT NULL DEFAULT 0"},
+	{name: "AdminKeyset", definition: "MEDIUMBLOB"},
+}
+
 type storage struct {
 	db *sql.DB
 }
@@ -85,9 +100,38 @@ func (s *storage) create() error {
 	if err != nil {
 		return fmt.Errorf("failed to create commitments tables: %v", err)
 	}
+	return s.addColumns()
+}
+
+// addColumns adds addedColumns to tables that were created without them.
+func (s *storage) addColumns() error {
+	for _, c := range addedColumns {
+		exists, err := s.columnExists(c.name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		alterSQL := fmt.Sprintf("ALTER TABLE Directories ADD COLUMN %s %s;", c.name, c.definition)
+		if _, err := s.db.Exec(alterSQL); err != nil {
+			// Another server may have added the column concurrently.
+			if exists, _ := s.columnExists(c.name); !exists {
+				return fmt.Errorf("failed to add column %v to directories table: %v", c.name, err)
+			}
+		}
+	}
 	return nil
 }
 
+func (s *storage) columnExists(name string) (bool, error) {
+	var count int
+	if err := s.db.QueryRow(columnExistsSQL, name).Scan(&count); err != nil {
+		return false, fmt.Errorf("failed to read columns of directories table: %v", err)
+	}
+	return count > 0, nil
+}
+
 func (s *storage) List(ctx context.Context, showDeleted bool) ([]*directory.Directory, error) {
 	var query string
 	if showDeleted {
@@ -117,6 +161,7 @@ func (s *storage) List(ctx context.Context, showDeleted bool) ([]*directory.Dire
 			&mapByte, &logByte,
 			&pubkey, &anyData,
 			&d.MinInterval, &d.MaxInterval,
+			&d.Mutator, &d.AllowReregistration, &d.AdminKeyset,
 			&d.Deleted); err != nil {
 			return nil, err
 		}
@@ -170,6 +215,7 @@ func (s *storage) Write(ctx context.Context, d *directory.Directory) error {
 		mapTree, logTree,
 		d.VRF.Der, anyData,
 		d.MinInterval.Nanoseconds(), d.MaxInterval.Nanoseconds(),
+		d.Mutator, d.AllowReregistration, d.AdminKeyset,
 		false,
 		// Store January 1, year 1, 00:00:00 UTC, the time.Time zero value.
 		// Store this as unix seconds till Jan 1 1970, a large negative number.
@@ -202,6 +248,7 @@ func (s *storage) Read(ctx context.Context, directoryID string, showDeleted bool
 		&mapByte, &logByte,
 		&pubkey, &anyData,
 		&d.MinInterval, &d.MaxInterval,
+		&d.Mutator, &d.AllowReregistration, &d.AdminKeyset,
 		&d.Deleted,
 		&deletedUnix,
 	); err == sql.ErrNoRows {
@@ -70,10 +70,13 @@ func TestList(t *testing.T) {
 					Log: &tpb.Tree{
 						TreeId: 2,
 					},
-					VRF:         &keyspb.PublicKey{Der: []byte("pubkeybytes")},
-					VRFPriv:     &keyspb.PrivateKey{Der: []byte("privkeybytes")},
-					MinInterval: 5 * time.Hour,
-					MaxInterval: 500 * time.Hour,
+					VRF:                 &keyspb.PublicKey{Der: []byte("pubkeybytes")},
+					VRFPriv:             &keyspb.PrivateKey{Der: []byte("privkeybytes")},
+					MinInterval:         5 * time.Hour,
+					MaxInterval:         500 * time.Hour,
+					Mutator:             "first-write-wins",
+					AllowReregistration: true,
+					AdminKeyset:         []byte("admin keyset"),
 				},
 			},
 		},
@@ -274,3 +277,66 @@ func TestDelete(t *testing.T) {
 		}
 	}
 }
+
+// legacyCreateSQL is the schema of the Directories table before columns were
+// added to it.
+const legacyCreateSQL = `
+CREATE TABLE Directories(
+  DirectoryId           VARCHAR(40) NOT NULL,
+  Map                   BLOB NOT NULL,
+  Log                   BLOB NOT NULL,
+  VRFPublicKey          MEDIUMBLOB NOT NULL,
+  VRFPrivateKey         MEDIUMBLOB NOT NULL,
+  MinInterval           BIGINT NOT NULL,
+  MaxInterval           BIGINT NOT NULL,
+  Deleted               INTEGER,
+  DeleteTimeSeconds      BIGINT,
+  PRIMARY KEY(DirectoryId)
+);`
+
+func TestAddColumns(t *testing.T) {
+	ctx := context.Background()
+	db := testdb.NewForTest(ctx, t)
+	if _, err := db.ExecContext(ctx, legacyCreateSQL); err != nil {
+		t.Fatalf("Create legacy table: %v", err)
+	}
+	// Adding columns to a table that already has them has no effect.
+	for i := 0; i < 2; i++ {
+		if _, err := NewStorage(db); err != nil {
+			t.Fatalf("NewStorage(): %v", err)
+		}
+	}
+	s := &storage{db: db}
+	for _, c := range addedColumns {
+		exists, err := s.columnExists(c.name)
+		if err != nil {
+			t.Fatalf("columnExists(%v): %v", c.name, err)
+		}
+		if !exists {
+			t.Errorf("columnExists(%v): false, want true", c.name)
+		}
+	}
+
+	d := &directory.Directory{
+		DirectoryID:         "migrated",
+		Map:                 &tpb.Tree{TreeId: 1},
+		Log:                 &tpb.Tree{TreeId: 2},
+		VRF:                 &keyspb.PublicKey{Der: []byte("pubkeybytes")},
+		VRFPriv:             &keyspb.PrivateKey{Der: []byte("privkeybytes")},
+		MinInterval:         1 * time.Second,
+		MaxInterval:         5 * time.Second,
+		Mutator:             "first-write-wins",
+		AllowReregistration: true,
+		AdminKeyset:         []byte("admin keyset"),
+	}
+	if err := s.Write(ctx, d); err != nil {
+		t.Fatalf("Write(): %v", err)
+	}
+	got, err := s.Read(ctx, d.DirectoryID, false)
+	if err != nil {
+		t.Fatalf("Read(): %v", err)
+	}
+	if !cmp.Equal(got, d, cmp.Comparer(proto.Equal)) {
+		t.Errorf("Read(): %#v, want %#v", got, d)
+	}
+}
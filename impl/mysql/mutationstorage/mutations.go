@@ -49,6 +49,13 @@ var (
 		LogID    BIGINT           NOT NULL,
 		Enabled  INTEGER          NOT NULL,
 		PRIMARY KEY(DirectoryID, LogID)
+	);`,
+		`CREATE TABLE IF NOT EXISTS Expiries (
+		DirectoryID    VARCHAR(30)   NOT NULL,
+		MapIndex       VARBINARY(32) NOT NULL,
+		NotAfterMicros BIGINT        NOT NULL, -- In microseconds from Unix epoch.
+		PRIMARY KEY(DirectoryID, MapIndex),
+		INDEX ExpiriesByNotAfter (DirectoryID, NotAfterMicros)
 	);`,
 	}
 )
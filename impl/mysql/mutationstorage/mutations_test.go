@@ -34,3 +34,16 @@ func TestBatchIntegration(t *testing.T) {
 
 	storagetest.RunBatchStorageTests(t, storageFactory)
 }
+
+func TestExpiriesIntegration(t *testing.T) {
+	storageFactory := func(ctx context.Context, t *testing.T, _ string) sequencer.Expiries {
+		db := testdb.NewForTest(ctx, t)
+		m, err := New(db)
+		if err != nil {
+			t.Fatalf("Failed to create mutations: %v", err)
+		}
+		return m
+	}
+
+	storagetest.RunExpiriesTests(t, storageFactory)
+}
@@ -0,0 +1,49 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package postgres
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+// PostgreSQL error codes.
+// See https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	uniqueViolation      = "23505"
+	serializationFailure = "40001"
+	deadlockDetected     = "40P01"
+)
+
+// IsDeadlock returns true if the error reports that a deadlock occurred or
+// that a serializable transaction conflicted with another transaction.
+// Either way, the transaction may succeed if it is retried.
+func IsDeadlock(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == deadlockDetected || pqErr.Code == serializationFailure
+	}
+	return false
+}
+
+// IsDuplicateEntry returns true if the error is a PostgreSQL duplicate key error.
+func IsDuplicateEntry(err error) bool {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code == uniqueViolation
+	}
+	return false
+}
@@ -0,0 +1,166 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package monitorresults implements the monitorstorage.Interface backed by a PostgreSQL table.
+package monitorresults
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"fmt"
+
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/keytransparency/core/monitorstorage"
+	"github.com/google/keytransparency/impl/postgres"
+
+	mopb "github.com/google/keytransparency/core/api/monitor/v1/monitor_go_proto"
+)
+
+const (
+	createSQL = `
+CREATE TABLE IF NOT EXISTS MonitorResults(
+  KTURL                 VARCHAR(255) NOT NULL,
+  DirectoryID           VARCHAR(40) NOT NULL,
+  Revision              BIGINT NOT NULL,
+  State                 BYTEA NOT NULL,
+  PRIMARY KEY(KTURL, DirectoryID, Revision)
+);`
+	createEquivocationsSQL = `
+CREATE TABLE IF NOT EXISTS MonitorEquivocations(
+  KTURL                 VARCHAR(255) NOT NULL,
+  DirectoryID           VARCHAR(40) NOT NULL,
+  Revision              BIGINT NOT NULL,
+  GossipedRootHash      BYTEA NOT NULL,
+  Equivocation          BYTEA NOT NULL,
+  PRIMARY KEY(KTURL, DirectoryID, Revision, GossipedRootHash)
+);`
+	writeSQL = `INSERT INTO MonitorResults (KTURL, DirectoryID, Revision, State) VALUES ($1, $2, $3, $4);`
+	readSQL  = `
+SELECT State FROM MonitorResults
+WHERE KTURL = $1 AND DirectoryID = $2 AND Revision = $3;`
+	latestSQL = `
+SELECT MAX(Revision) FROM MonitorResults
+WHERE KTURL = $1 AND DirectoryID = $2;`
+	writeEquivocationSQL = `INSERT INTO MonitorEquivocations
+(KTURL, DirectoryID, Revision, GossipedRootHash, Equivocation) VALUES ($1, $2, $3, $4, $5);`
+	readEquivocationsSQL = `
+SELECT Equivocation FROM MonitorEquivocations
+WHERE KTURL = $1 AND DirectoryID = $2
+ORDER BY Revision ASC;`
+)
+
+// Storage stores monitoring results in a PostgreSQL table.
+type Storage struct {
+	db *sql.DB
+}
+
+// New returns a monitorstorage.Interface backed by a PostgreSQL table.
+func New(db *sql.DB) (*Storage, error) {
+	s := &Storage{db: db}
+	for _, stmt := range []string{createSQL, createEquivocationsSQL} {
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, fmt.Errorf("failed to create monitor results tables: %v", err)
+		}
+	}
+	return s, nil
+}
+
+// Set stores the monitoring result for revision.
+// Returns monitorstorage.ErrAlreadyStored if a result for revision already exists.
+func (s *Storage) Set(ctx context.Context, ktURL, directoryID string, revision int64,
+	r *monitorstorage.Result) error {
+	state, err := r.Proto()
+	if err != nil {
+		return err
+	}
+	stateData, err := proto.Marshal(state)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, writeSQL, ktURL, directoryID, revision, stateData)
+	if postgres.IsDuplicateEntry(err) {
+		return monitorstorage.ErrAlreadyStored
+	}
+	return err
+}
+
+// Get returns the monitoring result for revision.
+// Returns monitorstorage.ErrNotFound if there is no result for revision.
+func (s *Storage) Get(ctx context.Context, ktURL, directoryID string, revision int64) (
+	*monitorstorage.Result, error) {
+	var stateData []byte
+	err := s.db.QueryRowContext(ctx, readSQL, ktURL, directoryID, revision).Scan(&stateData)
+	if err == sql.ErrNoRows {
+		return nil, monitorstorage.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	var state mopb.State
+	if err := proto.Unmarshal(stateData, &state); err != nil {
+		return nil, err
+	}
+	return monitorstorage.FromProto(&state)
+}
+
+// LatestRevision returns the highest revision stored for the directory.
+// Returns monitorstorage.ErrNotFound if no results are stored for the directory.
+func (s *Storage) LatestRevision(ctx context.Context, ktURL, directoryID string) (int64, error) {
+	var latest sql.NullInt64
+	if err := s.db.QueryRowContext(ctx, latestSQL, ktURL, directoryID).Scan(&latest); err != nil {
+		return 0, err
+	}
+	if !latest.Valid {
+		return 0, monitorstorage.ErrNotFound
+	}
+	return latest.Int64, nil
+}
+
+// AddEquivocation records an equivocation proof.
+// Proofs are identified by their revision and gossiped map root.
+func (s *Storage) AddEquivocation(ctx context.Context, e *mopb.Equivocation) error {
+	eData, err := proto.Marshal(e)
+	if err != nil {
+		return err
+	}
+	gossipedRootHash := sha256.Sum256(e.GetGossipedSmr().GetMapRoot())
+	_, err = s.db.ExecContext(ctx, writeEquivocationSQL,
+		e.GetKtUrl(), e.GetDirectoryId(), e.GetRevision(), gossipedRootHash[:], eData)
+	if postgres.IsDuplicateEntry(err) {
+		return nil
+	}
+	return err
+}
+
+// Equivocations returns the equivocation proofs recorded for a directory.
+func (s *Storage) Equivocations(ctx context.Context, ktURL, directoryID string) ([]*mopb.Equivocation, error) {
+	rows, err := s.db.QueryContext(ctx, readEquivocationsSQL, ktURL, directoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []*mopb.Equivocation
+	for rows.Next() {
+		var eData []byte
+		if err := rows.Scan(&eData); err != nil {
+			return nil, err
+		}
+		var e mopb.Equivocation
+		if err := proto.Unmarshal(eData, &e); err != nil {
+			return nil, err
+		}
+		ret = append(ret, &e)
+	}
+	return ret, rows.Err()
+}
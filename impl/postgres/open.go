@@ -0,0 +1,33 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package postgres provides functions for interacting with PostgreSQL.
+package postgres
+
+import (
+	"database/sql"
+
+	_ "github.com/lib/pq" // Register the postgres driver.
+)
+
+// Open the PostgreSQL database specified by the dsn string.
+// The dsn may be a URL, postgres://user@host/dbname?sslmode=disable, or a
+// list of key=value settings.
+func Open(dsn string) (*sql.DB, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	return db, db.Ping()
+}
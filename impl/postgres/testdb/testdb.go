@@ -0,0 +1,82 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testdb supports opening ephemeral databases for testing.
+package testdb
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	ktsql "github.com/google/keytransparency/impl/postgres"
+)
+
+var dataSourceURI = flag.String("kt_test_postgres_uri", "postgres://postgres@localhost:5432/postgres?sslmode=disable",
+	"The PostgreSQL URI to use when running tests")
+
+// requiredEnv is the environment variable that makes tests fail, rather than
+// skip, when there is no PostgreSQL server. CI sets it so that the PostgreSQL
+// tests cannot be skipped unnoticed.
+const requiredEnv = "POSTGRES_TEST"
+
+// NewForTest creates a temporary database.
+// The test is skipped if there is no PostgreSQL server at dataSourceURI,
+// unless the POSTGRES_TEST environment variable is set.
+// The database is dropped when the test completes.
+func NewForTest(ctx context.Context, t testing.TB) *sql.DB {
+	t.Helper()
+	admin, err := ktsql.Open(*dataSourceURI)
+	if err != nil {
+		if os.Getenv(requiredEnv) != "" {
+			t.Fatalf("PostgreSQL not available and %v is set: %v", requiredEnv, err)
+		}
+		t.Skipf("PostgreSQL not available: %v", err)
+	}
+
+	dbName := fmt.Sprintf("test_%v", time.Now().UnixNano())
+	if _, err := admin.ExecContext(ctx, fmt.Sprintf(`CREATE DATABASE "%s"`, dbName)); err != nil {
+		admin.Close()
+		t.Fatalf("Failed to create test database: %v", err)
+	}
+
+	// Open test database
+	u, err := url.Parse(*dataSourceURI)
+	if err != nil {
+		admin.Close()
+		t.Fatalf("url.Parse(%v): %v", *dataSourceURI, err)
+	}
+	u.Path = "/" + dbName
+	db, err := ktsql.Open(u.String())
+	if err != nil {
+		admin.Close()
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		ctx := context.Background()
+		defer admin.Close()
+		db.Close()
+		if _, err := admin.ExecContext(ctx, fmt.Sprintf(`DROP DATABASE "%s"`, dbName)); err != nil {
+			log.Printf("Failed to drop test database %q: %v", dbName, err)
+		}
+	})
+	return db
+}
@@ -14,7 +14,15 @@
 
 package spanner
 
-import "cloud.google.com/go/spanner/spansql"
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/spanner/spansql"
+
+	database "cloud.google.com/go/spanner/admin/database/apiv1"
+	databasepb "google.golang.org/genproto/googleapis/spanner/admin/database/v1"
+)
 
 //go:generate sh gen.sh
 
@@ -30,3 +38,107 @@ func ReadDDL() ([]string, error) {
 	}
 	return stmts, nil
 }
+
+// migrations are the schema changes that were made after the schema was first
+// released. New columns are nullable, and a NULL value preserves the behavior
+// of rows that were written before the column existed.
+var migrations = []string{
+	`CREATE TABLE MonitorResults (
+  KTURL                 STRING(MAX) NOT NULL,
+  DirectoryID           STRING(100) NOT NULL,
+  Revision              INT64 NOT NULL,
+  State                 BYTES(MAX) NOT NULL,
+) PRIMARY KEY(KTURL, DirectoryID, Revision)`,
+	`CREATE TABLE MonitorEquivocations (
+  KTURL                 STRING(MAX) NOT NULL,
+  DirectoryID           STRING(100) NOT NULL,
+  Revision              INT64 NOT NULL,
+  GossipedRootHash      BYTES(32) NOT NULL,
+  Equivocation          BYTES(MAX) NOT NULL,
+) PRIMARY KEY(KTURL, DirectoryID, Revision, GossipedRootHash)`,
+	"ALTER TABLE Directories ADD COLUMN Mutator STRING(100)",
+	"ALTER TABLE Directories ADD COLUMN AllowReregistration BOOL",
+	"ALTER TABLE Directories ADD COLUMN AdminKeyset BYTES(MAX)",
+	`CREATE TABLE Expiries (
+  DirectoryID           STRING(100) NOT NULL,
+  MapIndex              BYTES(32) NOT NULL,
+  NotAfter              TIMESTAMP NOT NULL,
+) PRIMARY KEY(DirectoryID, MapIndex)`,
+	"CREATE INDEX ExpiriesByNotAfter ON Expiries(DirectoryID, NotAfter)",
+}
+
+// MigrationDDL returns the statements in migrations that are needed to bring a
+// database with the schema in existing up to date with ReadDDL.
+func MigrationDDL(existing []string) ([]string, error) {
+	columns := make(map[string]map[string]bool) // Map of table to column names.
+	indexes := make(map[string]bool)
+	for _, stmt := range existing {
+		s, err := spansql.ParseDDLStmt(stmt)
+		if err != nil {
+			return nil, err
+		}
+		switch s := s.(type) {
+		case *spansql.CreateTable:
+			columns[s.Name] = make(map[string]bool)
+			for _, c := range s.Columns {
+				columns[s.Name][c.Name] = true
+			}
+		case *spansql.CreateIndex:
+			indexes[s.Name] = true
+		}
+	}
+	if len(columns) == 0 {
+		return nil, nil // The database has no schema to migrate.
+	}
+	var stmts []string
+	for _, m := range migrations {
+		s, err := spansql.ParseDDLStmt(m)
+		if err != nil {
+			return nil, err
+		}
+		var needed bool
+		switch s := s.(type) {
+		case *spansql.CreateTable:
+			_, ok := columns[s.Name]
+			needed = !ok
+		case *spansql.CreateIndex:
+			needed = !indexes[s.Name]
+		case *spansql.AlterTable:
+			add, ok := s.Alteration.(spansql.AddColumn)
+			if !ok {
+				return nil, fmt.Errorf("migration %q does not add a column", m)
+			}
+			cols, ok := columns[s.Name]
+			needed = ok && !cols[add.Def.Name]
+		default:
+			return nil, fmt.Errorf("unsupported migration %q", m)
+		}
+		if needed {
+			stmts = append(stmts, m)
+		}
+	}
+	return stmts, nil
+}
+
+// Migrate adds the tables, columns and indexes that are missing from db.
+func Migrate(ctx context.Context, admin *database.DatabaseAdminClient, db string) error {
+	resp, err := admin.GetDatabaseDdl(ctx, &databasepb.GetDatabaseDdlRequest{Database: db})
+	if err != nil {
+		return err
+	}
+	stmts, err := MigrationDDL(resp.GetStatements())
+	if err != nil {
+		return err
+	}
+	if len(stmts) == 0 {
+		return nil
+	}
+	op, err := admin.UpdateDatabaseDdl(ctx, &databasepb.UpdateDatabaseDdlRequest{
+		Database:   db,
+		Statements: stmts,
+	})
+	if err != nil {
+		return err
+	}
+	return op.Wait(ctx)
+}
@@ -17,6 +17,8 @@ package spanner
 import (
 	"testing"
 
+	"cloud.google.com/go/spanner/spansql"
+
 	_ "github.com/google/keytransparency/impl/spanner/testutil" // Support test flags
 )
 
@@ -26,3 +28,80 @@ func TestDDL(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestMigrationDDL(t *testing.T) {
+	current, err := ReadDDL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacy := []string{`CREATE TABLE Directories (
+  DirectoryID              STRING(100) NOT NULL,
+  Map                      BYTES(MAX),
+  Log                      BYTES(MAX),
+  VRFPublicKey             BYTES(MAX),
+  VRFPrivateKey            BYTES(MAX),
+  MinInterval              INT64,
+  MaxInterval              INT64,
+  Deleted                  BOOL,
+  DeleteTime               TIMESTAMP,
+) PRIMARY KEY(DirectoryID)`}
+	// preSeries is the schema before the monitor, mutator and expiry changes.
+	preSeries := append(legacy[:1:1],
+		`CREATE TABLE LogStatus (
+  DirectoryID          STRING(100) NOT NULL,
+  LogID                INT64 NOT NULL,
+  WriteToLog           BOOL NOT NULL,
+) PRIMARY KEY(DirectoryID, LogID),
+  INTERLEAVE IN PARENT Directories ON DELETE CASCADE`,
+		`CREATE TABLE Batches (
+  DirectoryID          STRING(100) NOT NULL,
+  Revision             INT64 NOT NULL,
+  Meta                 BYTES(1024),
+) PRIMARY KEY(DirectoryID, Revision),
+  INTERLEAVE IN PARENT Directories ON DELETE CASCADE`,
+		`CREATE TABLE Mutations (
+  DirectoryID           STRING(100) NOT NULL,
+  LogID                 INT64 NOT NULL,
+  Timestamp             TIMESTAMP NOT NULL OPTIONS (allow_commit_timestamp=true),
+  LocalID               INT64 NOT NULL,
+  Mutation              BYTES(MAX) NOT NULL,
+) PRIMARY KEY(DirectoryID, LogID, Timestamp, LocalID),
+  INTERLEAVE IN PARENT Directories ON DELETE CASCADE`)
+	for _, tc := range []struct {
+		desc       string
+		existing   []string
+		want       int
+		wantTables []string
+	}{
+		{desc: "current", existing: current, want: 0},
+		{desc: "legacy", existing: legacy, want: len(migrations)},
+		{desc: "pre-series", existing: preSeries, want: len(migrations),
+			wantTables: []string{"MonitorResults", "MonitorEquivocations"}},
+		{desc: "empty", existing: nil, want: 0},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			stmts, err := MigrationDDL(tc.existing)
+			if err != nil {
+				t.Fatalf("MigrationDDL(): %v", err)
+			}
+			if got := len(stmts); got != tc.want {
+				t.Errorf("MigrationDDL(): %v, want %v statements", stmts, tc.want)
+			}
+			created := make(map[string]bool)
+			for _, stmt := range stmts {
+				s, err := spansql.ParseDDLStmt(stmt)
+				if err != nil {
+					t.Fatalf("ParseDDLStmt(%q): %v", stmt, err)
+				}
+				if ct, ok := s.(*spansql.CreateTable); ok {
+					created[ct.Name] = true
+				}
+			}
+			for _, table := range tc.wantTables {
+				if !created[table] {
+					t.Errorf("MigrationDDL(): %v, want CREATE TABLE %v", stmts, table)
+				}
+			}
+		})
+	}
+}
@@ -35,15 +35,18 @@ const table = "Directories"
 
 // dirRow represents one row in the Directories table in Spanner.
 type dirRow struct {
-	DirectoryID   string
-	Map           []byte
-	Log           []byte
-	VRFPublicKey  []byte
-	VRFPrivateKey []byte
-	MinInterval   int64
-	MaxInterval   int64
-	Deleted       bool
-	DeleteTime    time.Time
+	DirectoryID         string
+	Map                 []byte
+	Log                 []byte
+	VRFPublicKey        []byte
+	VRFPrivateKey       []byte
+	MinInterval         int64
+	MaxInterval         int64
+	Mutator             spanner.NullString
+	AllowReregistration spanner.NullBool
+	AdminKeyset         []byte
+	Deleted             bool
+	DeleteTime          time.Time
 }
 
 var dirColumns = []string{
@@ -54,6 +57,9 @@ var dirColumns = []string{
 	"VRFPrivateKey",
 	"MinInterval",
 	"MaxInterval",
+	"Mutator",
+	"AllowReregistration",
+	"AdminKeyset",
 	"Deleted",
 	"DeleteTime",
 }
@@ -89,14 +95,17 @@ func unpackRow(row *spanner.Row) (*directory.Directory, error) {
 	}
 
 	return &directory.Directory{
-		DirectoryID: r.DirectoryID,
-		Map:         &tmap,
-		Log:         &tlog,
-		VRF:         &keyspb.PublicKey{Der: r.VRFPublicKey},
-		VRFPriv:     vrfPriv,
-		MinInterval: time.Duration(r.MinInterval) * time.Nanosecond,
-		MaxInterval: time.Duration(r.MaxInterval) * time.Nanosecond,
-		Deleted:     r.Deleted,
+		DirectoryID:         r.DirectoryID,
+		Map:                 &tmap,
+		Log:                 &tlog,
+		VRF:                 &keyspb.PublicKey{Der: r.VRFPublicKey},
+		VRFPriv:             vrfPriv,
+		MinInterval:         time.Duration(r.MinInterval) * time.Nanosecond,
+		MaxInterval:         time.Duration(r.MaxInterval) * time.Nanosecond,
+		Mutator:             r.Mutator.StringVal,
+		AllowReregistration: r.AllowReregistration.Bool,
+		AdminKeyset:         r.AdminKeyset,
+		Deleted:             r.Deleted,
 	}, nil
 }
 
@@ -144,13 +153,16 @@ func (t *Table) Write(ctx context.Context, dir *directory.Directory) error {
 	}
 
 	m, err := spanner.InsertStruct(table, dirRow{
-		DirectoryID:   dir.DirectoryID,
-		Map:           tmap,
-		Log:           tlog,
-		VRFPublicKey:  dir.VRF.GetDer(),
-		VRFPrivateKey: keyData,
-		MinInterval:   dir.MinInterval.Nanoseconds(),
-		MaxInterval:   dir.MaxInterval.Nanoseconds(),
+		DirectoryID:         dir.DirectoryID,
+		Map:                 tmap,
+		Log:                 tlog,
+		VRFPublicKey:        dir.VRF.GetDer(),
+		VRFPrivateKey:       keyData,
+		MinInterval:         dir.MinInterval.Nanoseconds(),
+		MaxInterval:         dir.MaxInterval.Nanoseconds(),
+		Mutator:             spanner.NullString{StringVal: dir.Mutator, Valid: true},
+		AllowReregistration: spanner.NullBool{Bool: dir.AllowReregistration, Valid: true},
+		AdminKeyset:         dir.AdminKeyset,
 	})
 	if err != nil {
 		return err
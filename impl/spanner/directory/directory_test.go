@@ -60,14 +60,17 @@ func TestList(t *testing.T) {
 			MaxInterval: 5 * time.Second,
 		},
 		{
-			DirectoryID: "directory2",
-			Map:         &tpb.Tree{TreeId: 1},
-			Log:         &tpb.Tree{TreeId: 2},
-			VRF:         &keyspb.PublicKey{Der: []byte("pubkeybytes")},
-			VRFPriv:     &keyspb.PrivateKey{Der: []byte("privkeybytes")},
-			MinInterval: 5 * time.Hour,
-			MaxInterval: 500 * time.Hour,
-			Deleted:     true,
+			DirectoryID:         "directory2",
+			Map:                 &tpb.Tree{TreeId: 1},
+			Log:                 &tpb.Tree{TreeId: 2},
+			VRF:                 &keyspb.PublicKey{Der: []byte("pubkeybytes")},
+			VRFPriv:             &keyspb.PrivateKey{Der: []byte("privkeybytes")},
+			MinInterval:         5 * time.Hour,
+			MaxInterval:         500 * time.Hour,
+			Mutator:             "first-write-wins",
+			AllowReregistration: true,
+			AdminKeyset:         []byte("admin keyset"),
+			Deleted:             true,
 		},
 	}
 	for _, d := range directories {
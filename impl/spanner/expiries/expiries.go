@@ -0,0 +1,101 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package expiries stores the not_after times of map entries.
+package expiries
+
+import (
+	"context"
+	"time"
+
+	"cloud.google.com/go/spanner"
+	"github.com/golang/protobuf/ptypes"
+
+	spb "github.com/google/keytransparency/core/sequencer/sequencer_go_proto"
+)
+
+const table = "Expiries"
+
+// Table implements sequencer.Expiries
+type Table struct {
+	client *spanner.Client
+}
+
+// New returns a new Table.
+func New(client *spanner.Client) *Table {
+	return &Table{client: client}
+}
+
+// WriteExpiries sets the not_after time of the entry at each index. An expiry
+// without a not_after time clears the expiry of its entry.
+func (t *Table) WriteExpiries(ctx context.Context, directoryID string, expiries []*spb.RevisionMetadata_Expiry) error {
+	// Cols are columns of the Expiries table.
+	type Cols struct {
+		DirectoryID string
+		MapIndex    []byte
+		NotAfter    time.Time
+	}
+	ms := make([]*spanner.Mutation, 0, len(expiries))
+	for _, x := range expiries {
+		if x.GetNotAfter() == nil {
+			ms = append(ms, spanner.Delete(table, spanner.Key{directoryID, x.GetIndex()}))
+			continue
+		}
+		notAfter, err := ptypes.Timestamp(x.GetNotAfter())
+		if err != nil {
+			return err
+		}
+		m, err := spanner.InsertOrUpdateStruct(table, Cols{
+			DirectoryID: directoryID,
+			MapIndex:    x.GetIndex(),
+			NotAfter:    notAfter,
+		})
+		if err != nil {
+			return err
+		}
+		ms = append(ms, m)
+	}
+	_, err := t.client.Apply(ctx, ms)
+	return err
+}
+
+// ReadExpired returns the expiries of directoryID with a not_after time in
+// [start, end), ordered by index.
+func (t *Table) ReadExpired(ctx context.Context, directoryID string, start, end time.Time) ([]*spb.RevisionMetadata_Expiry, error) {
+	rtx := t.client.Single()
+	defer rtx.Close()
+
+	stmt := spanner.NewStatement(`SELECT MapIndex, NotAfter FROM Expiries
+		WHERE DirectoryID = @directoryID AND NotAfter >= @start AND NotAfter < @end
+		ORDER BY MapIndex`)
+	stmt.Params["directoryID"] = directoryID
+	stmt.Params["start"] = start
+	stmt.Params["end"] = end
+	var ret []*spb.RevisionMetadata_Expiry
+	err := rtx.Query(ctx, stmt).Do(
+		func(row *spanner.Row) error {
+			var index []byte
+			var notAfter time.Time
+			if err := row.Columns(&index, &notAfter); err != nil {
+				return err
+			}
+			ts, err := ptypes.TimestampProto(notAfter)
+			if err != nil {
+				return err
+			}
+			ret = append(ret, &spb.RevisionMetadata_Expiry{Index: index, NotAfter: ts})
+			return nil
+		})
+	return ret, err
+}
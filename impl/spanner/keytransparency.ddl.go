@@ -18,6 +18,9 @@ CREATE TABLE Directories (
   VRFPrivateKey            BYTES(MAX),
   MinInterval              INT64,
   MaxInterval              INT64,
+  Mutator                  STRING(100),
+  AllowReregistration      BOOL,
+  AdminKeyset              BYTES(MAX),
   Deleted                  BOOL,
   DeleteTime               TIMESTAMP,
 ) PRIMARY KEY (DirectoryID);
@@ -47,4 +50,30 @@ CREATE TABLE Mutations (
   Mutation              BYTES(MAX) NOT NULL,
 ) PRIMARY KEY(DirectoryID, LogID, Timestamp, LocalID),
   INTERLEAVE IN PARENT Directories ON DELETE CASCADE;
+
+-- Monitor Results
+CREATE TABLE MonitorResults (
+  KTURL                 STRING(MAX) NOT NULL,
+  DirectoryID           STRING(100) NOT NULL,
+  Revision              INT64 NOT NULL,
+  State                 BYTES(MAX) NOT NULL,
+) PRIMARY KEY(KTURL, DirectoryID, Revision);
+
+-- Monitor Equivocations
+CREATE TABLE MonitorEquivocations (
+  KTURL                 STRING(MAX) NOT NULL,
+  DirectoryID           STRING(100) NOT NULL,
+  Revision              INT64 NOT NULL,
+  GossipedRootHash      BYTES(32) NOT NULL,
+  Equivocation          BYTES(MAX) NOT NULL,
+) PRIMARY KEY(KTURL, DirectoryID, Revision, GossipedRootHash);
+
+-- Expiries
+CREATE TABLE Expiries (
+  DirectoryID           STRING(100) NOT NULL,
+  MapIndex              BYTES(32) NOT NULL,
+  NotAfter              TIMESTAMP NOT NULL,
+) PRIMARY KEY(DirectoryID, MapIndex);
+
+CREATE INDEX ExpiriesByNotAfter ON Expiries(DirectoryID, NotAfter);
 `
@@ -0,0 +1,188 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package monitorresults stores the results of monitoring Key Transparency directories.
+package monitorresults
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"cloud.google.com/go/spanner"
+	"github.com/golang/protobuf/proto" //nolint:staticcheck
+	"github.com/google/keytransparency/core/monitorstorage"
+	"google.golang.org/grpc/codes"
+
+	mopb "github.com/google/keytransparency/core/api/monitor/v1/monitor_go_proto"
+)
+
+const (
+	table             = "MonitorResults"
+	equivocationTable = "MonitorEquivocations"
+)
+
+// Table implements monitorstorage.Interface
+type Table struct {
+	client *spanner.Client
+}
+
+// New returns a new Table.
+func New(client *spanner.Client) *Table {
+	return &Table{client: client}
+}
+
+// Set stores the monitoring result for revision.
+// Returns monitorstorage.ErrAlreadyStored if a result for revision already exists.
+func (t *Table) Set(ctx context.Context, ktURL, directoryID string, revision int64,
+	r *monitorstorage.Result) error {
+	state, err := r.Proto()
+	if err != nil {
+		return err
+	}
+	stateBytes, err := proto.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	// Cols are columns of the MonitorResults table.
+	type Cols struct {
+		KTURL       string
+		DirectoryID string
+		Revision    int64
+		State       []byte
+	}
+	m, err := spanner.InsertStruct(table, Cols{
+		KTURL:       ktURL,
+		DirectoryID: directoryID,
+		Revision:    revision,
+		State:       stateBytes,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = t.client.Apply(ctx, []*spanner.Mutation{m})
+	if spanner.ErrCode(err) == codes.AlreadyExists {
+		return monitorstorage.ErrAlreadyStored
+	}
+	return err
+}
+
+// Get returns the monitoring result for revision.
+// Returns monitorstorage.ErrNotFound if there is no result for revision.
+func (t *Table) Get(ctx context.Context, ktURL, directoryID string, revision int64) (
+	*monitorstorage.Result, error) {
+	rtx := t.client.Single()
+	defer rtx.Close()
+
+	var stateBytes []byte
+	r, err := rtx.ReadRow(ctx, table, spanner.Key{ktURL, directoryID, revision}, []string{"State"})
+	if spanner.ErrCode(err) == codes.NotFound {
+		return nil, monitorstorage.ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+	if err := r.ColumnByName("State", &stateBytes); err != nil {
+		return nil, err
+	}
+	var state mopb.State
+	if err := proto.Unmarshal(stateBytes, &state); err != nil {
+		return nil, err
+	}
+	return monitorstorage.FromProto(&state)
+}
+
+// LatestRevision returns the highest revision stored for the directory.
+// Returns monitorstorage.ErrNotFound if no results are stored for the directory.
+func (t *Table) LatestRevision(ctx context.Context, ktURL, directoryID string) (int64, error) {
+	rtx := t.client.Single()
+	defer rtx.Close()
+
+	// TODO: Replace with MAX(Revision) when spansql supports aggregate operators.
+	stmt := spanner.NewStatement(`SELECT Revision FROM MonitorResults
+		WHERE KTURL = @ktURL AND DirectoryID = @directoryID
+		ORDER BY Revision DESC LIMIT 1`)
+	stmt.Params["ktURL"] = ktURL
+	stmt.Params["directoryID"] = directoryID
+	var rev int64
+	var found bool
+	if err := rtx.Query(ctx, stmt).Do(
+		func(row *spanner.Row) error {
+			found = true
+			return row.Columns(&rev)
+		}); err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, monitorstorage.ErrNotFound
+	}
+	return rev, nil
+}
+
+// AddEquivocation records an equivocation proof.
+// Proofs are identified by their revision and gossiped map root.
+func (t *Table) AddEquivocation(ctx context.Context, e *mopb.Equivocation) error {
+	eBytes, err := proto.Marshal(e)
+	if err != nil {
+		return err
+	}
+	gossipedRootHash := sha256.Sum256(e.GetGossipedSmr().GetMapRoot())
+
+	// Cols are columns of the MonitorEquivocations table.
+	type Cols struct {
+		KTURL            string
+		DirectoryID      string
+		Revision         int64
+		GossipedRootHash []byte
+		Equivocation     []byte
+	}
+	m, err := spanner.InsertOrUpdateStruct(equivocationTable, Cols{
+		KTURL:            e.GetKtUrl(),
+		DirectoryID:      e.GetDirectoryId(),
+		Revision:         e.GetRevision(),
+		GossipedRootHash: gossipedRootHash[:],
+		Equivocation:     eBytes,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = t.client.Apply(ctx, []*spanner.Mutation{m})
+	return err
+}
+
+// Equivocations returns the equivocation proofs recorded for a directory.
+func (t *Table) Equivocations(ctx context.Context, ktURL, directoryID string) ([]*mopb.Equivocation, error) {
+	rtx := t.client.Single()
+	defer rtx.Close()
+
+	stmt := spanner.NewStatement(`SELECT Equivocation FROM MonitorEquivocations
+		WHERE KTURL = @ktURL AND DirectoryID = @directoryID
+		ORDER BY Revision`)
+	stmt.Params["ktURL"] = ktURL
+	stmt.Params["directoryID"] = directoryID
+	var ret []*mopb.Equivocation
+	err := rtx.Query(ctx, stmt).Do(
+		func(row *spanner.Row) error {
+			var eBytes []byte
+			if err := row.Columns(&eBytes); err != nil {
+				return err
+			}
+			var e mopb.Equivocation
+			if err := proto.Unmarshal(eBytes, &e); err != nil {
+				return err
+			}
+			ret = append(ret, &e)
+			return nil
+		})
+	return ret, err
+}
@@ -0,0 +1,75 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/mattn/go-sqlite3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsBusy(t *testing.T) {
+	for _, test := range []struct {
+		err  error
+		want bool
+	}{
+		{want: false, err: nil},
+		{want: false, err: fmt.Errorf("foobar")},
+		{want: false, err: status.Errorf(codes.PermissionDenied, "denied")},
+		{want: false, err: sqlite3.Error{Code: sqlite3.ErrConstraint}},
+		{want: true, err: sqlite3.Error{Code: sqlite3.ErrBusy}},
+		{want: true, err: sqlite3.Error{Code: sqlite3.ErrLocked}},
+		{want: true, err: fmt.Errorf("wrapped: %w", sqlite3.Error{Code: sqlite3.ErrBusy})},
+	} {
+		if got := IsBusy(test.err); got != test.want {
+			t.Errorf("IsBusy(%v): %v, want %v", test.err, got, test.want)
+		}
+	}
+}
+
+func TestIsDuplicateEntry(t *testing.T) {
+	ctx := context.Background()
+	db, err := Open(":memory:")
+	if err != nil {
+		t.Fatalf("Open(): %v", err)
+	}
+	defer db.Close()
+	if _, err := db.ExecContext(ctx, `CREATE TABLE T (ID INTEGER NOT NULL, PRIMARY KEY(ID));`); err != nil {
+		t.Fatalf("CREATE TABLE: %v", err)
+	}
+	if _, err := db.ExecContext(ctx, `INSERT INTO T (ID) VALUES (1);`); err != nil {
+		t.Fatalf("INSERT: %v", err)
+	}
+	_, dupErr := db.ExecContext(ctx, `INSERT INTO T (ID) VALUES (1);`)
+
+	for _, test := range []struct {
+		err  error
+		want bool
+	}{
+		{want: false, err: nil},
+		{want: false, err: fmt.Errorf("foobar")},
+		{want: false, err: sqlite3.Error{Code: sqlite3.ErrBusy}},
+		{want: true, err: dupErr},
+		{want: true, err: fmt.Errorf("wrapped: %w", dupErr)},
+	} {
+		if got := IsDuplicateEntry(test.err); got != test.want {
+			t.Errorf("IsDuplicateEntry(%v): %v, want %v", test.err, got, test.want)
+		}
+	}
+}
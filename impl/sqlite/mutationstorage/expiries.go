@@ -0,0 +1,99 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mutationstorage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	spb "github.com/google/keytransparency/core/sequencer/sequencer_go_proto"
+)
+
+// WriteExpiries sets the not_after time of the entry at each index. An expiry
+// without a not_after time clears the expiry of its entry.
+func (m *Mutations) WriteExpiries(ctx context.Context, directoryID string,
+	expiries []*spb.RevisionMetadata_Expiry) (ret error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer func() {
+		if ret != nil {
+			if err := tx.Rollback(); err != nil {
+				ret = fmt.Errorf("%v, and could not rollback: %w", ret, err)
+			}
+		}
+	}()
+
+	for _, x := range expiries {
+		if x.GetNotAfter() == nil {
+			if _, err := tx.ExecContext(ctx,
+				`DELETE FROM Expiries WHERE DirectoryID = ? AND MapIndex = ?;`,
+				directoryID, x.GetIndex()); err != nil {
+				return fmt.Errorf("failed deleting expiry: %w", err)
+			}
+			continue
+		}
+		notAfter, err := ptypes.Timestamp(x.GetNotAfter())
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx,
+			`INSERT INTO Expiries (DirectoryID, MapIndex, NotAfterMicros) VALUES (?, ?, ?)
+			ON CONFLICT (DirectoryID, MapIndex) DO UPDATE SET NotAfterMicros = excluded.NotAfterMicros;`,
+			directoryID, x.GetIndex(), notAfter.UnixNano()/1000); err != nil {
+			return fmt.Errorf("failed writing expiry: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+	return nil
+}
+
+// ReadExpired returns the expiries of directoryID with a not_after time in
+// [start, end), ordered by index. Times are stored with microsecond precision.
+func (m *Mutations) ReadExpired(ctx context.Context, directoryID string,
+	start, end time.Time) ([]*spb.RevisionMetadata_Expiry, error) {
+	rows, err := m.db.QueryContext(ctx,
+		`SELECT MapIndex, NotAfterMicros FROM Expiries
+		WHERE DirectoryID = ? AND NotAfterMicros >= ? AND NotAfterMicros < ?
+		ORDER BY MapIndex;`,
+		directoryID, start.UnixNano()/1000, end.UnixNano()/1000)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var ret []*spb.RevisionMetadata_Expiry
+	for rows.Next() {
+		var index []byte
+		var micros int64
+		if err := rows.Scan(&index, &micros); err != nil {
+			return nil, err
+		}
+		notAfter, err := ptypes.TimestampProto(time.Unix(0, micros*1000))
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, &spb.RevisionMetadata_Expiry{Index: index, NotAfter: notAfter})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows.Err(): %w", err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2020 Google Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sqlite provides functions for interacting with SQLite.
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "github.com/mattn/go-sqlite3" // Register the sqlite3 driver.
+)
+
+// Open the SQLite database stored in the file at path.
+// Use ":memory:" for a temporary in-memory database.
+func Open(path string) (*sql.DB, error) {
+	// Take the write lock when a transaction starts so that concurrent
+	// transactions wait for each other instead of failing to upgrade their
+	// locks. Wait up to busy_timeout milliseconds for the lock.
+	dsn := fmt.Sprintf("file:%s?_txlock=immediate&_busy_timeout=5000", path)
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, err
+	}
+	// SQLite allows a single writer at a time. A single connection also keeps
+	// ":memory:" databases from being split across connections.
+	db.SetMaxOpenConns(1)
+	return db, db.Ping()
+}
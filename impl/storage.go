@@ -17,21 +17,38 @@ package impl
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"cloud.google.com/go/spanner"
 	"github.com/google/keytransparency/core/directory"
+	"github.com/google/keytransparency/core/fake"
+	"github.com/google/keytransparency/core/monitorstorage"
 	"github.com/google/keytransparency/core/sequencer"
 	"github.com/google/keytransparency/core/water"
+	"github.com/google/keytransparency/impl/memory"
 	"github.com/google/keytransparency/impl/mysql"
+	"github.com/google/keytransparency/impl/postgres"
+	"github.com/google/keytransparency/impl/sqlite"
 	"gocloud.dev/server/health"
 	"gocloud.dev/server/health/sqlhealth"
 
+	database "cloud.google.com/go/spanner/admin/database/apiv1"
 	pb "github.com/google/keytransparency/core/api/v1/keytransparency_go_proto"
 	mysqldir "github.com/google/keytransparency/impl/mysql/directory"
+	mysqlmonitor "github.com/google/keytransparency/impl/mysql/monitorresults"
 	mysqlmutations "github.com/google/keytransparency/impl/mysql/mutationstorage"
+	pgdir "github.com/google/keytransparency/impl/postgres/directory"
+	pgmonitor "github.com/google/keytransparency/impl/postgres/monitorresults"
+	pgmutations "github.com/google/keytransparency/impl/postgres/mutationstorage"
+	ktspanner "github.com/google/keytransparency/impl/spanner"
 	spanbatch "github.com/google/keytransparency/impl/spanner/batch"
 	spandir "github.com/google/keytransparency/impl/spanner/directory"
+	spanexpiries "github.com/google/keytransparency/impl/spanner/expiries"
+	spanmonitor "github.com/google/keytransparency/impl/spanner/monitorresults"
 	spanmutations "github.com/google/keytransparency/impl/spanner/mutations"
+	sqlitedir "github.com/google/keytransparency/impl/sqlite/directory"
+	sqlitemonitor "github.com/google/keytransparency/impl/sqlite/monitorresults"
+	sqlitemutations "github.com/google/keytransparency/impl/sqlite/mutationstorage"
 )
 
 // Storage holds an abstract storage implementation
@@ -50,37 +67,87 @@ type Storage struct {
 		// Copied methods from keyserver.MutationLogs
 		SendBatch(ctx context.Context, directoryID string, logID int64, batch []*pb.EntryUpdate) (water.Mark, error)
 	}
-	Batches       sequencer.Batcher
-	HealthChecker health.Checker
-	Close         func()
+	Batches        sequencer.Batcher
+	Expiries       sequencer.Expiries
+	MonitorResults monitorstorage.Interface
+	HealthChecker  health.Checker
+	Close          func()
 }
 
 // StorageEngines returns a list of supported storage engines.
-func StorageEngines() []string { return []string{"mysql", "cloud_spanner"} }
+func StorageEngines() []string {
+	return []string{"mysql", "cloud_spanner", "postgres", "sqlite", "inmemory"}
+}
 
 // NewStorage returns a Storage with the requested engine.
+//
+// The sqlite engine stores all data in the single file named by db.
+//
+// The inmemory engine keeps all data in the memory of the current process.
+// Calls with the same db name share the same data, so servers that run in
+// the same process can be pointed at the same store.
 func NewStorage(ctx context.Context, engine, db string) (*Storage, error) {
 	switch engine {
 	case "mysql":
 		return mysqlStorage(db)
 	case "cloud_spanner":
 		return spannerStorage(ctx, db)
+	case "postgres":
+		return postgresStorage(db)
+	case "sqlite":
+		return sqliteStorage(db)
+	case "inmemory":
+		return memoryStorage(db), nil
 	default:
 		return nil, fmt.Errorf("unknown db engine %s", engine)
 	}
 }
 
+var (
+	memoryMu     sync.Mutex
+	memoryStores = make(map[string]*Storage) // Map of db name to in-memory storage.
+)
+
+func memoryStorage(db string) *Storage {
+	memoryMu.Lock()
+	defer memoryMu.Unlock()
+	if s, ok := memoryStores[db]; ok {
+		return s
+	}
+	s := &Storage{
+		Directories:    memory.NewDirectoryStorage(),
+		Batches:        memory.NewBatches(),
+		Expiries:       memory.NewExpiries(),
+		Logs:           memory.NewMutationLogs(),
+		MonitorResults: fake.NewMonitorStorage(),
+		HealthChecker:  health.CheckerFunc(func() error { return nil }),
+		Close:          func() {},
+	}
+	memoryStores[db] = s
+	return s
+}
+
 func spannerStorage(ctx context.Context, db string) (*Storage, error) {
+	adminClient, err := database.NewDatabaseAdminClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer adminClient.Close()
+	if err := ktspanner.Migrate(ctx, adminClient, db); err != nil {
+		return nil, fmt.Errorf("failed to update spanner schema: %v", err)
+	}
 	spanClient, err := spanner.NewClient(ctx, db)
 	if err != nil {
 		return nil, err
 	}
 	return &Storage{
-		Directories:   spandir.New(spanClient),
-		Batches:       spanbatch.New(spanClient),
-		Logs:          spanmutations.New(spanClient),
-		HealthChecker: health.CheckerFunc(func() error { return nil }),
-		Close:         spanClient.Close,
+		Directories:    spandir.New(spanClient),
+		Batches:        spanbatch.New(spanClient),
+		Expiries:       spanexpiries.New(spanClient),
+		Logs:           spanmutations.New(spanClient),
+		MonitorResults: spanmonitor.New(spanClient),
+		HealthChecker:  health.CheckerFunc(func() error { return nil }),
+		Close:          spanClient.Close,
 	}, nil
 }
 
@@ -99,11 +166,80 @@ func mysqlStorage(db string) (*Storage, error) {
 		sqldb.Close()
 		return nil, fmt.Errorf("failed to create mutations storage: %w", err)
 	}
+	monitorResults, err := mysqlmonitor.New(sqldb)
+	if err != nil {
+		sqldb.Close()
+		return nil, fmt.Errorf("failed to create monitor results storage: %w", err)
+	}
+	return &Storage{
+		Directories:    directories,
+		Batches:        logs,
+		Expiries:       logs,
+		Logs:           logs,
+		MonitorResults: monitorResults,
+		HealthChecker:  sqlhealth.New(sqldb),
+		Close:          func() { sqldb.Close() },
+	}, nil
+}
+
+func postgresStorage(db string) (*Storage, error) {
+	sqldb, err := postgres.Open(db)
+	if err != nil {
+		return nil, err
+	}
+	directories, err := pgdir.NewStorage(sqldb)
+	if err != nil {
+		sqldb.Close()
+		return nil, fmt.Errorf("failed to create directory storage: %w", err)
+	}
+	logs, err := pgmutations.New(sqldb)
+	if err != nil {
+		sqldb.Close()
+		return nil, fmt.Errorf("failed to create mutations storage: %w", err)
+	}
+	monitorResults, err := pgmonitor.New(sqldb)
+	if err != nil {
+		sqldb.Close()
+		return nil, fmt.Errorf("failed to create monitor results storage: %w", err)
+	}
+	return &Storage{
+		Directories:    directories,
+		Batches:        logs,
+		Expiries:       logs,
+		Logs:           logs,
+		MonitorResults: monitorResults,
+		HealthChecker:  sqlhealth.New(sqldb),
+		Close:          func() { sqldb.Close() },
+	}, nil
+}
+
+func sqliteStorage(db string) (*Storage, error) {
+	sqldb, err := sqlite.Open(db)
+	if err != nil {
+		return nil, err
+	}
+	directories, err := sqlitedir.NewStorage(sqldb)
+	if err != nil {
+		sqldb.Close()
+		return nil, fmt.Errorf("failed to create directory storage: %w", err)
+	}
+	logs, err := sqlitemutations.New(sqldb)
+	if err != nil {
+		sqldb.Close()
+		return nil, fmt.Errorf("failed to create mutations storage: %w", err)
+	}
+	monitorResults, err := sqlitemonitor.New(sqldb)
+	if err != nil {
+		sqldb.Close()
+		return nil, fmt.Errorf("failed to create monitor results storage: %w", err)
+	}
 	return &Storage{
-		Directories:   directories,
-		Batches:       logs,
-		Logs:          logs,
-		HealthChecker: sqlhealth.New(sqldb),
-		Close:         func() { sqldb.Close() },
+		Directories:    directories,
+		Batches:        logs,
+		Expiries:       logs,
+		Logs:           logs,
+		MonitorResults: monitorResults,
+		HealthChecker:  sqlhealth.New(sqldb),
+		Close:          func() { sqldb.Close() },
 	}, nil
 }